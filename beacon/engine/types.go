@@ -426,6 +426,42 @@ type ExecutionPayloadBody struct {
 	Withdrawals     []*types.Withdrawal `json:"withdrawals"`
 }
 
+// Outcomes of a transaction forced into a payload via PayloadAttributes.Transactions.
+const (
+	ForcedTxSuccess  = "success"  // Included, execution succeeded
+	ForcedTxReverted = "reverted" // Included, execution reverted
+	ForcedTxRejected = "rejected" // Failed validation, the payload could not be built
+	ForcedTxSkipped  = "skipped"  // Not executed, an earlier forced transaction was rejected
+)
+
+// ForcedTransactionResult reports the execution of a single transaction forced
+// into a payload by the rollup node.
+type ForcedTransactionResult struct {
+	Index   hexutil.Uint64 `json:"index"`
+	Hash    common.Hash    `json:"hash"`
+	Type    hexutil.Uint64 `json:"type"`
+	From    common.Address `json:"from"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	Gas     hexutil.Uint64 `json:"gas"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Status  string         `json:"status"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// PayloadDebugV1 is the response to engine_getPayloadDebug, reporting how the
+// transactions forced into a payload were executed. For payloads that failed
+// to build, the block fields are omitted and Error holds the build error.
+type PayloadDebugV1 struct {
+	PayloadID          PayloadID                  `json:"payloadId"`
+	ParentHash         common.Hash                `json:"parentHash"`
+	Timestamp          hexutil.Uint64             `json:"timestamp"`
+	Number             *hexutil.Uint64            `json:"number,omitempty"`
+	BlockHash          *common.Hash               `json:"blockHash,omitempty"`
+	GasUsed            *hexutil.Uint64            `json:"gasUsed,omitempty"`
+	Error              string                     `json:"error,omitempty"`
+	ForcedTransactions []*ForcedTransactionResult `json:"forcedTransactions"`
+}
+
 // Client identifiers to support ClientVersionV1.
 const (
 	ClientCode = "GE"
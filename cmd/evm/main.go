@@ -113,6 +113,21 @@ var (
 		Usage:    "enable return data output",
 		Category: traceCategory,
 	}
+	TraceTracerFlag = &cli.StringFlag{
+		Name:     "trace.tracer",
+		Usage:    "Configures the use of a custom tracer, e.g native or js tracers. The result of the tracer is printed after the execution",
+		Category: traceCategory,
+	}
+	TraceTracerConfigFlag = &cli.StringFlag{
+		Name:     "trace.jsonconfig",
+		Usage:    "The configurations for the custom tracer specified by --trace.tracer. If provided, must be in JSON format",
+		Category: traceCategory,
+	}
+	TracePprofFlag = &cli.StringFlag{
+		Name:     "trace.pprof",
+		Usage:    "Profiles the gas usage with the gasProfilerTracer, writing the gzipped pprof profile to the given file",
+		Category: traceCategory,
+	}
 
 	// Deprecated flags.
 	DebugFlag = &cli.BoolFlag{
@@ -39,6 +39,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/internal/flags"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/triedb"
@@ -66,6 +67,9 @@ var runCommand = &cli.Command{
 		ValueFlag,
 		StatDumpFlag,
 		DumpFlag,
+		TraceTracerFlag,
+		TraceTracerConfigFlag,
+		TracePprofFlag,
 	}, traceFlags),
 }
 
@@ -231,6 +235,34 @@ func runCmd(ctx *cli.Context) error {
 	prestate, _ = state.New(genesis.Root(), sdb)
 	chainConfig = genesisConfig.Config
 
+	// The custom tracer takes precedence over the tracing flags
+	var (
+		customTracer *tracers.Tracer
+		name         = ctx.String(TraceTracerFlag.Name)
+		pprofFile    = ctx.String(TracePprofFlag.Name)
+	)
+	if pprofFile != "" {
+		if name != "" && name != "gasProfilerTracer" {
+			fmt.Printf("Flag --%s requires the gasProfilerTracer, not %q\n", TracePprofFlag.Name, name)
+			os.Exit(1)
+		}
+		name = "gasProfilerTracer"
+	}
+	if name != "" {
+		var config json.RawMessage
+		if pprofFile != "" {
+			config = json.RawMessage(`{"pprof": true}`)
+		} else if ctx.IsSet(TraceTracerConfigFlag.Name) {
+			config = json.RawMessage(ctx.String(TraceTracerConfigFlag.Name))
+		}
+		t, err := tracers.DefaultDirectory.New(name, &tracers.Context{}, config, chainConfig)
+		if err != nil {
+			fmt.Printf("Failed to create tracer %q: %v\n", name, err)
+			os.Exit(1)
+		}
+		customTracer, tracer = t, t.Hooks
+	}
+
 	if ctx.String(SenderFlag.Name) != "" {
 		sender = common.HexToAddress(ctx.String(SenderFlag.Name))
 	}
@@ -363,6 +395,20 @@ allocations:     %d
 allocated bytes: %d
 `, stats.GasUsed, stats.Time, stats.Allocs, stats.BytesAllocated)
 	}
+	if customTracer != nil {
+		result, err := customTracer.GetResult()
+		if err != nil {
+			fmt.Printf("Failed to retrieve trace result: %v\n", err)
+			return err
+		}
+		if pprofFile != "" {
+			if result, err = writeGasProfile(pprofFile, result); err != nil {
+				fmt.Printf("Failed to write gas profile: %v\n", err)
+				return err
+			}
+		}
+		fmt.Println(string(result))
+	}
 	if tracer == nil {
 		fmt.Printf("%#x\n", output)
 		if err != nil {
@@ -385,3 +431,21 @@ func writeLogs(writer io.Writer, logs []*types.Log) {
 		fmt.Fprintln(writer)
 	}
 }
+
+// writeGasProfile writes the pprof profile of the gas profiler result to the
+// given file, returning the result without the profile.
+func writeGasProfile(file string, result json.RawMessage) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(result, &fields); err != nil {
+		return nil, err
+	}
+	var profile []byte
+	if err := json.Unmarshal(fields["pprof"], &profile); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(file, profile, 0644); err != nil {
+		return nil, err
+	}
+	delete(fields, "pprof")
+	return json.Marshal(fields)
+}
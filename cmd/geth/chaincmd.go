@@ -62,6 +62,9 @@ var (
 			utils.OverrideBPO1,
 			utils.OverrideBPO2,
 			utils.OverrideVerkle,
+			utils.OverrideMantleEverest,
+			utils.OverrideMantleSkadi,
+			utils.OverrideMantleLimb,
 		}, utils.DatabaseFlags),
 		Description: `
 The init command initializes a new genesis block and definition for the network.
@@ -119,6 +122,7 @@ if one is set.  Otherwise it prints the genesis from the datadir.`,
 			utils.LogNoHistoryFlag,
 			utils.LogExportCheckpointsFlag,
 			utils.StateHistoryFlag,
+			utils.TrienodeHistoryFlag,
 		}, utils.DatabaseFlags, debug.Flags),
 		Before: func(ctx *cli.Context) error {
 			flags.MigrateGlobalFlags(ctx)
@@ -288,6 +292,18 @@ func initGenesis(ctx *cli.Context) error {
 		v := ctx.Uint64(utils.OverrideVerkle.Name)
 		overrides.OverrideVerkle = &v
 	}
+	if ctx.IsSet(utils.OverrideMantleEverest.Name) {
+		v := ctx.Uint64(utils.OverrideMantleEverest.Name)
+		overrides.OverrideMantleEverest = &v
+	}
+	if ctx.IsSet(utils.OverrideMantleSkadi.Name) {
+		v := ctx.Uint64(utils.OverrideMantleSkadi.Name)
+		overrides.OverrideMantleSkadi = &v
+	}
+	if ctx.IsSet(utils.OverrideMantleLimb.Name) {
+		v := ctx.Uint64(utils.OverrideMantleLimb.Name)
+		overrides.OverrideMantleLimb = &v
+	}
 
 	chaindb := utils.MakeChainDatabase(ctx, stack, false)
 	defer chaindb.Close()
@@ -251,6 +251,18 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 		override := ctx.Bool(utils.OverrideOptimism.Name)
 		cfg.Eth.OverrideOptimism = &override
 	}
+	if ctx.IsSet(utils.OverrideMantleEverest.Name) {
+		v := ctx.Uint64(utils.OverrideMantleEverest.Name)
+		cfg.Eth.OverrideMantleEverest = &v
+	}
+	if ctx.IsSet(utils.OverrideMantleSkadi.Name) {
+		v := ctx.Uint64(utils.OverrideMantleSkadi.Name)
+		cfg.Eth.OverrideMantleSkadi = &v
+	}
+	if ctx.IsSet(utils.OverrideMantleLimb.Name) {
+		v := ctx.Uint64(utils.OverrideMantleLimb.Name)
+		cfg.Eth.OverrideMantleLimb = &v
+	}
 
 	// Start metrics export if enabled
 	utils.SetupMetrics(&cfg.Metrics)
@@ -299,6 +311,17 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 		if err != nil {
 			utils.Fatalf("failed to register dev mode catalyst service: %v", err)
 		}
+		if ctx.Bool(utils.DeveloperRollupFlag.Name) {
+			rollupConfig := new(catalyst.RollupDevConfig)
+			if file := ctx.String(utils.DeveloperRollupConfigFlag.Name); file != "" {
+				if rollupConfig, err = catalyst.LoadRollupDevConfig(file); err != nil {
+					utils.Fatalf("failed to load rollup dev config: %v", err)
+				}
+			}
+			if err := simBeacon.EnableRollup(rollupConfig); err != nil {
+				utils.Fatalf("failed to enable rollup dev mode: %v", err)
+			}
+		}
 		catalyst.RegisterSimulatedBeaconAPIs(stack, simBeacon)
 		stack.RegisterLifecycle(simBeacon)
 
@@ -315,7 +338,20 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 		stack.RegisterLifecycle(blsyncer)
 	} else {
 		// Launch the engine API for interacting with external consensus client.
-		err := catalyst.Register(stack, eth)
+		var recorder *catalyst.Recorder
+		if path := ctx.String(utils.RollupEngineRecordFlag.Name); path != "" {
+			var err error
+			recorder, err = catalyst.NewRecorder(catalyst.RecorderConfig{
+				Path:       path,
+				MaxSize:    ctx.Int(utils.RollupEngineRecordMaxSizeFlag.Name),
+				MaxBackups: ctx.Int(utils.RollupEngineRecordMaxBackupsFlag.Name),
+			})
+			if err != nil {
+				utils.Fatalf("failed to create engine API recorder: %v", err)
+			}
+			log.Info("Recording engine API calls", "path", path)
+		}
+		err := catalyst.RegisterWithRecorder(stack, eth, recorder)
 		if err != nil {
 			utils.Fatalf("failed to register catalyst service: %v", err)
 		}
@@ -17,6 +17,7 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"os"
@@ -83,6 +84,8 @@ Remove blockchain and state databases`,
 			dbMetadataCmd,
 			dbCheckStateContentCmd,
 			dbInspectHistoryCmd,
+			dbExportStateHistoryCmd,
+			dbImportStateHistoryCmd,
 		},
 	}
 	dbInspectCmd = &cli.Command{
@@ -207,6 +210,47 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		}, utils.NetworkFlags, utils.DatabaseFlags),
 		Description: "This command queries the history of the account or storage slot within the specified block range",
 	}
+	dbExportStateHistoryCmd = &cli.Command{
+		Action:    exportStateHistory,
+		Name:      "export-state-history",
+		Usage:     "Export the state histories within block range into a file",
+		ArgsUsage: "<file>",
+		Flags: slices.Concat([]cli.Flag{
+			&cli.Uint64Flag{
+				Name:  "start",
+				Usage: "block number of the range start, zero means earliest history",
+			},
+			&cli.Uint64Flag{
+				Name:  "end",
+				Usage: "block number of the range end(included), zero means latest history",
+			},
+			utils.TrienodeHistoryFlag,
+		}, utils.NetworkFlags, utils.DatabaseFlags),
+		Description: `This command exports the state histories within the specified block range into
+a self-describing and checksummed file, which can be imported by another node with
+the import-state-history command. The history index is not exported, it's rebuilt by
+the importing node. The trienode histories are exported as well if
+--history.trienode is specified.`,
+	}
+	dbImportStateHistoryCmd = &cli.Command{
+		Action:    importStateHistory,
+		Name:      "import-state-history",
+		Usage:     "Import the state histories from a file exported by export-state-history",
+		ArgsUsage: "<file>",
+		Flags: slices.Concat([]cli.Flag{
+			utils.StateHistoryFlag,
+			utils.TrienodeHistoryFlag,
+		}, utils.NetworkFlags, utils.DatabaseFlags),
+		Description: `This command imports the state histories from the given file, extending the
+local state histories backwards. The histories in the file must be contiguous with
+the local ones. The file carries no history index, the index is rebuilt from scratch
+in the background on the next startup. The trienode histories are imported as well
+if --history.trienode is specified.
+
+The import is refused if the imported histories exceed the limit of --history.state,
+as they would be pruned on the next startup. Pass the limit the node runs with, e.g.
+--history.state=0 to retain the entire history. Note the import must not be interrupted.`,
+	}
 )
 
 func removeDB(ctx *cli.Context) error {
@@ -836,6 +880,25 @@ func inspectStorage(db *triedb.Database, start uint64, end uint64, address commo
 	return nil
 }
 
+// historyID resolves the id of the state history associated with the given
+// block. State histories are identified by state ID rather than block number,
+// the conversion is performed by the state root of the block.
+func historyID(db ethdb.Database, triedb *triedb.Database, blockNumber uint64) (uint64, error) {
+	header := rawdb.ReadHeader(db, rawdb.ReadCanonicalHash(db, blockNumber), blockNumber)
+	if header == nil {
+		return 0, fmt.Errorf("block #%d is not existent", blockNumber)
+	}
+	id := rawdb.ReadStateID(db, header.Root)
+	if id == nil {
+		first, last, err := triedb.HistoryRange()
+		if err == nil {
+			return 0, fmt.Errorf("history of block #%d is not existent, available history range: [#%d-#%d]", blockNumber, first, last)
+		}
+		return 0, fmt.Errorf("history of block #%d is not existent", blockNumber)
+	}
+	return *id, nil
+}
+
 func inspectHistory(ctx *cli.Context) error {
 	if ctx.NArg() == 0 || ctx.NArg() > 2 {
 		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
@@ -867,28 +930,10 @@ func inspectHistory(ctx *cli.Context) error {
 		start uint64 // the id of first history object to query
 		end   uint64 // the id (included) of last history object to query
 	)
-	// State histories are identified by state ID rather than block number.
-	// To address this, load the corresponding block header and perform the
-	// conversion by this function.
-	blockToID := func(blockNumber uint64) (uint64, error) {
-		header := rawdb.ReadHeader(db, rawdb.ReadCanonicalHash(db, blockNumber), blockNumber)
-		if header == nil {
-			return 0, fmt.Errorf("block #%d is not existent", blockNumber)
-		}
-		id := rawdb.ReadStateID(db, header.Root)
-		if id == nil {
-			first, last, err := triedb.HistoryRange()
-			if err == nil {
-				return 0, fmt.Errorf("history of block #%d is not existent, available history range: [#%d-#%d]", blockNumber, first, last)
-			}
-			return 0, fmt.Errorf("history of block #%d is not existent", blockNumber)
-		}
-		return *id, nil
-	}
 	// Parse the starting block number for inspection.
 	startNumber := ctx.Uint64("start")
 	if startNumber != 0 {
-		start, err = blockToID(startNumber)
+		start, err = historyID(db, triedb, startNumber)
 		if err != nil {
 			return err
 		}
@@ -896,7 +941,7 @@ func inspectHistory(ctx *cli.Context) error {
 	// Parse the ending block number for inspection.
 	endBlock := ctx.Uint64("end")
 	if endBlock != 0 {
-		end, err = blockToID(endBlock)
+		end, err = historyID(db, triedb, endBlock)
 		if err != nil {
 			return err
 		}
@@ -907,3 +952,82 @@ func inspectHistory(ctx *cli.Context) error {
 	}
 	return inspectStorage(triedb, start, end, address, slot, ctx.Bool("raw"))
 }
+
+func exportStateHistory(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	triedb := utils.MakeTrieDatabase(ctx, stack, db, false, true, false)
+	defer triedb.Close()
+
+	var (
+		err   error
+		start uint64 // the id of first history object to export
+		end   uint64 // the id (included) of last history object to export
+	)
+	if number := ctx.Uint64("start"); number != 0 {
+		start, err = historyID(db, triedb, number)
+		if err != nil {
+			return err
+		}
+	}
+	if number := ctx.Uint64("end"); number != 0 {
+		end, err = historyID(db, triedb, number)
+		if err != nil {
+			return err
+		}
+	}
+	fn := ctx.Args().First()
+	f, err := os.Create(fn)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	begin := time.Now()
+	writer := bufio.NewWriter(f)
+	if err := triedb.ExportHistory(writer, start, end); err != nil {
+		os.Remove(fn)
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		os.Remove(fn)
+		return err
+	}
+	log.Info("Exported state histories", "file", fn, "elapsed", common.PrettyDuration(time.Since(begin)))
+	return nil
+}
+
+func importStateHistory(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	fn := ctx.Args().First()
+	f, err := os.Open(fn)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	triedb := utils.MakeTrieDatabase(ctx, stack, db, false, false, false)
+	defer triedb.Close()
+
+	begin := time.Now()
+	if err := triedb.ImportHistory(f); err != nil {
+		return err
+	}
+	log.Info("Imported state histories", "file", fn, "elapsed", common.PrettyDuration(time.Since(begin)))
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/catalyst"
+	"github.com/ethereum/go-ethereum/internal/flags"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+)
+
+var engineReplayCommand = &cli.Command{
+	Action:    engineReplay,
+	Name:      "engine-replay",
+	Usage:     "Replay a recording of engine API calls against a copy of a datadir",
+	ArgsUsage: "<recording file or directory>",
+	Flags:     slices.Concat(nodeFlags, rpcFlags),
+	Before: func(ctx *cli.Context) error {
+		flags.MigrateGlobalFlags(ctx)
+		return nil
+	},
+	Description: `
+The engine-replay command feeds the engine API calls recorded with --rollup.enginerecord
+into the node, in the order they were originally made, and reports the first payload
+whose block hash, state root, receipts or validity differs from the recording.
+
+The datadir must contain the chain as it was when the recording started, and is
+modified by the replay: always run the command against a copy of the datadir. The
+node runs with networking disabled.
+
+Payloads built from the transaction pool are rebuilt with the transactions of the
+recorded payload forced into the block, so that the replay is deterministic.`,
+}
+
+// engineReplay replays a recording of engine API calls.
+func engineReplay(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		utils.Fatalf("This command requires a single argument.")
+	}
+	records, err := catalyst.ReadRecording(ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("Failed to read engine API recording: %v", err)
+	}
+	stack, cfg := makeConfigNode(ctx)
+	defer stack.Close()
+
+	// Never reach out to the network, the replay must only depend on the
+	// datadir and the recording.
+	srv := stack.Server()
+	srv.ListenAddr = ""
+	srv.MaxPeers = 0
+	srv.NoDiscovery = true
+	srv.NoDial = true
+
+	_, backend := utils.RegisterEthService(stack, &cfg.Eth)
+	if err := stack.Start(); err != nil {
+		utils.Fatalf("Failed to start node: %v", err)
+	}
+	log.Info("Replaying engine API calls", "calls", len(records), "head", backend.BlockChain().CurrentBlock().Number)
+
+	start := time.Now()
+	result, err := catalyst.Replay(backend, records)
+	if err != nil {
+		return err
+	}
+	log.Info("Replayed engine API calls", "calls", result.Calls, "payloads", result.Payloads, "elapsed", common.PrettyDuration(time.Since(start)))
+	if div := result.Divergence; div != nil {
+		fmt.Printf("Divergence at call %d (%s), block %d [%x]\n", div.Index, div.Method, div.Number, div.Hash)
+		fmt.Printf("  %s recorded: %s\n", div.Field, div.Recorded)
+		fmt.Printf("  %s replayed: %s\n", div.Field, div.Replayed)
+		return errors.New("replay diverged from the recording")
+	}
+	fmt.Printf("Replayed %d engine API calls without divergence\n", result.Calls)
+	return nil
+}
@@ -70,6 +70,9 @@ var (
 		utils.OverrideOptimismBedrock,
 		utils.OverrideOptimismRegolith,
 		utils.OverrideOptimism,
+		utils.OverrideMantleEverest,
+		utils.OverrideMantleSkadi,
+		utils.OverrideMantleLimb,
 		utils.TxPoolFromPreconfsFlag,
 		utils.TxPoolToPreconfsFlag,
 		utils.TxPoolAllPreconfsFlag,
@@ -100,7 +103,10 @@ var (
 		utils.LogHistoryFlag,
 		utils.LogNoHistoryFlag,
 		utils.LogExportCheckpointsFlag,
+		utils.TraceIndexFlag,
+		utils.TraceIndexHistoryFlag,
 		utils.StateHistoryFlag,
+		utils.TrienodeHistoryFlag,
 		utils.LightKDFFlag,
 		utils.EthRequiredBlocksFlag,
 		utils.LegacyWhitelistFlag, // deprecated
@@ -146,6 +152,8 @@ var (
 		utils.DeveloperFlag,
 		utils.DeveloperGasLimitFlag,
 		utils.DeveloperPeriodFlag,
+		utils.DeveloperRollupFlag,
+		utils.DeveloperRollupConfigFlag,
 		utils.VMEnableDebugFlag,
 		utils.VMTraceFlag,
 		utils.VMTraceJsonConfigFlag,
@@ -159,12 +167,19 @@ var (
 		utils.GpoIgnoreGasPriceFlag,
 		utils.GpoMinSuggestedPriorityFeeFlag,
 		utils.RollupSequencerHTTPFlag,
+		utils.RollupSequencerRetriesFlag,
+		utils.RollupSequencerHealthCheckFlag,
 		utils.RollupHistoricalRPCFlag,
 		utils.RollupHistoricalRPCTimeoutFlag,
+		utils.RollupHistoricalRPCMethodTimeoutsFlag,
+		utils.RollupHistoricalRPCCacheFlag,
 		utils.RollupDisableTxPoolGossipFlag,
 		utils.RollupEnableTxPoolAdmissionFlag,
 		utils.RollupComputePendingBlock,
 		utils.RollupMantleUpgradesFlag,
+		utils.RollupEngineRecordFlag,
+		utils.RollupEngineRecordMaxSizeFlag,
+		utils.RollupEngineRecordMaxBackupsFlag,
 		configFileFlag,
 		utils.LogDebugFlag,
 		utils.LogBacktraceAtFlag,
@@ -206,10 +221,15 @@ var (
 		utils.RPCGlobalGasCapFlag,
 		utils.RPCGlobalEVMTimeoutFlag,
 		utils.RPCGlobalTxFeeCapFlag,
+		utils.RPCBlockRangeLimitFlag,
+		utils.RPCBlockRangeConcurrencyFlag,
+		utils.RPCTokenRatioMarginFlag,
 		utils.RPCGlobalLogQueryLimit,
 		utils.AllowUnprotectedTxs,
 		utils.BatchRequestLimit,
 		utils.BatchResponseMaxSize,
+		utils.RPCAPIKeysFlag,
+		utils.RPCResponseCacheSizeFlag,
 	}
 
 	metricsFlags = []cli.Flag{
@@ -270,6 +290,8 @@ func init() {
 		snapshotCommand,
 		// See verkle.go
 		verkleCommand,
+		// See enginecmd.go
+		engineReplayCommand,
 	}
 	if logTestCommand != nil {
 		app.Commands = append(app.Commands, logTestCommand)
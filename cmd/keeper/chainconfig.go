@@ -17,14 +17,20 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/params"
 )
 
 // getChainConfig returns the appropriate chain configuration based on the chainID.
-// Returns an error for unsupported chain IDs.
-func getChainConfig(chainID uint64) (*params.ChainConfig, error) {
+// Mantle chain configs are not bundled with the client and have to be supplied
+// along with the payload as JSON. Returns an error for unsupported chain IDs.
+func getChainConfig(chainID uint64, encoded []byte) (*params.ChainConfig, error) {
+	if len(encoded) > 0 {
+		return decodeMantleChainConfig(chainID, encoded)
+	}
 	switch chainID {
 	case 0, params.MainnetChainConfig.ChainID.Uint64():
 		return params.MainnetChainConfig, nil
@@ -33,6 +39,69 @@ func getChainConfig(chainID uint64) (*params.ChainConfig, error) {
 	case params.HoodiChainConfig.ChainID.Uint64():
 		return params.HoodiChainConfig, nil
 	default:
+		if params.IsBundledMantleChain(new(big.Int).SetUint64(chainID)) {
+			return nil, fmt.Errorf("chain ID %d requires a chain config in the payload", chainID)
+		}
 		return nil, fmt.Errorf("unsupported chain ID: %d", chainID)
 	}
 }
+
+// decodeMantleChainConfig decodes a chain config supplied with the payload. Only
+// rollup configs are accepted, so that the bundled L1 configs can't be replaced,
+// and the upgrade schedule of the known Mantle networks must match the bundled
+// one.
+func decodeMantleChainConfig(chainID uint64, encoded []byte) (*params.ChainConfig, error) {
+	config := new(params.ChainConfig)
+	if err := json.Unmarshal(encoded, config); err != nil {
+		return nil, fmt.Errorf("invalid chain config: %v", err)
+	}
+	if config.Optimism == nil {
+		return nil, fmt.Errorf("chain config of chain %v is not a rollup config", config.ChainID)
+	}
+	if config.ChainID == nil || !config.ChainID.IsUint64() || config.ChainID.Uint64() != chainID {
+		return nil, fmt.Errorf("chain config ID mismatch: have %v, want %d", config.ChainID, chainID)
+	}
+	if params.IsBundledMantleChain(config.ChainID) {
+		bundled := params.GetUpgradeConfigForMantle(config.ChainID)
+		have, want := config.MantleForks(), mantleForks(bundled)
+		for i := range have {
+			if !equalTimes(have[i].Time, want[i].Time) {
+				return nil, fmt.Errorf("mantle %s fork mismatch: have %v, want %v", have[i].Name, fmtTime(have[i].Time), fmtTime(want[i].Time))
+			}
+		}
+	}
+	if err := config.CheckConfigForkOrder(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// mantleForks returns the forks of an upgrade schedule in the same order as
+// params.ChainConfig.MantleForks.
+func mantleForks(upgrades *params.MantleUpgradeChainConfig) []params.MantleFork {
+	config := &params.ChainConfig{
+		BaseFeeTime:           upgrades.BaseFeeTime,
+		BVMETHMintUpgradeTime: upgrades.BVMETHMintUpgradeTime,
+		MetaTxV2UpgradeTime:   upgrades.MetaTxV2UpgradeTime,
+		MetaTxV3UpgradeTime:   upgrades.MetaTxV3UpgradeTime,
+		ProxyOwnerUpgradeTime: upgrades.ProxyOwnerUpgradeTime,
+		MantleEverestTime:     upgrades.MantleEverestTime,
+		MantleSkadiTime:       upgrades.MantleSkadiTime,
+		MantleLimbTime:        upgrades.MantleLimbTime,
+	}
+	return config.MantleForks()
+}
+
+func equalTimes(a, b *uint64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func fmtTime(t *uint64) string {
+	if t == nil {
+		return "nil"
+	}
+	return fmt.Sprint(*t)
+}
@@ -29,11 +29,13 @@ import (
 )
 
 // Payload represents the input data for stateless execution containing
-// a block and its associated witness data for verification.
+// a block and its associated witness data for verification. Rollup chains,
+// whose configs are not bundled, carry their JSON encoded chain config.
 type Payload struct {
-	ChainID uint64
-	Block   *types.Block
-	Witness *stateless.Witness
+	ChainID     uint64
+	Block       *types.Block
+	Witness     *stateless.Witness
+	ChainConfig []byte `rlp:"optional"`
 }
 
 func init() {
@@ -45,7 +47,7 @@ func main() {
 	var payload Payload
 	rlp.DecodeBytes(input, &payload)
 
-	chainConfig, err := getChainConfig(payload.ChainID)
+	chainConfig, err := getChainConfig(payload.ChainID, payload.ChainConfig)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to get chain config: %v\n", err)
 		os.Exit(13)
@@ -180,6 +180,16 @@ var (
 		Value:    11500000,
 		Category: flags.DevCategory,
 	}
+	DeveloperRollupFlag = &cli.BoolFlag{
+		Name:     "dev.rollup",
+		Usage:    "Run the developer chain as a Mantle rollup, starting every block with an L1 attributes deposit",
+		Category: flags.DevCategory,
+	}
+	DeveloperRollupConfigFlag = &cli.StringFlag{
+		Name:     "dev.rollup.config",
+		Usage:    "JSON file with the L1 fee schedule and the user deposits of the rollup developer chain",
+		Category: flags.DevCategory,
+	}
 
 	IdentityFlag = &cli.StringFlag{
 		Name:     "identity",
@@ -278,6 +288,21 @@ var (
 		Usage:    "Manually specify optimism",
 		Category: flags.EthCategory,
 	}
+	OverrideMantleEverest = &cli.Uint64Flag{
+		Name:     "override.mantleeverest",
+		Usage:    "Manually specify the Mantle Everest fork timestamp, overriding the bundled setting",
+		Category: flags.EthCategory,
+	}
+	OverrideMantleSkadi = &cli.Uint64Flag{
+		Name:     "override.mantleskadi",
+		Usage:    "Manually specify the Mantle Skadi fork timestamp, overriding the bundled setting",
+		Category: flags.EthCategory,
+	}
+	OverrideMantleLimb = &cli.Uint64Flag{
+		Name:     "override.mantlelimb",
+		Usage:    "Manually specify the Mantle Limb fork timestamp, overriding the bundled setting",
+		Category: flags.EthCategory,
+	}
 	SyncModeFlag = &cli.StringFlag{
 		Name:     "syncmode",
 		Usage:    `Blockchain sync mode ("snap" or "full")`,
@@ -307,6 +332,11 @@ var (
 		Value:    ethconfig.Defaults.StateHistory,
 		Category: flags.StateCategory,
 	}
+	TrienodeHistoryFlag = &cli.BoolFlag{
+		Name:     "history.trienode",
+		Usage:    "Retain trie node histories for serving historical proofs, only relevant in state.scheme=path and gcmode=archive",
+		Category: flags.StateCategory,
+	}
 	TransactionHistoryFlag = &cli.Uint64Flag{
 		Name:     "history.transactions",
 		Usage:    "Number of recent blocks to maintain transactions index for (default = about one year, 0 = entire chain)",
@@ -336,6 +366,16 @@ var (
 		Category: flags.StateCategory,
 		Value:    "",
 	}
+	TraceIndexFlag = &cli.BoolFlag{
+		Name:     "history.traces",
+		Usage:    "Maintain an address index of the call traces of imported blocks for trace_filter",
+		Category: flags.StateCategory,
+	}
+	TraceIndexHistoryFlag = &cli.Uint64Flag{
+		Name:     "history.traces.blocks",
+		Usage:    "Number of recent blocks to maintain call trace index for (0 = all blocks since the index is enabled, older blocks are not indexed)",
+		Category: flags.StateCategory,
+	}
 	// Beacon client light sync settings
 	BeaconApiFlag = &cli.StringSliceFlag{
 		Name:     "beacon.api",
@@ -688,6 +728,24 @@ var (
 		Value:    ethconfig.Defaults.RPCTxFeeCap,
 		Category: flags.APICategory,
 	}
+	RPCBlockRangeLimitFlag = &cli.Uint64Flag{
+		Name:     "rpc.blockrangelimit",
+		Usage:    "Maximum number of blocks past the first one returned at once by eth_getBlockRange",
+		Value:    ethconfig.Defaults.RPCBlockRangeLimit,
+		Category: flags.APICategory,
+	}
+	RPCBlockRangeConcurrencyFlag = &cli.IntFlag{
+		Name:     "rpc.blockrangeconcurrency",
+		Usage:    "Number of blocks fetched concurrently by eth_getBlockRange",
+		Value:    ethconfig.Defaults.RPCBlockRangeConcurrency,
+		Category: flags.APICategory,
+	}
+	RPCTokenRatioMarginFlag = &cli.Float64Flag{
+		Name:     "rpc.tokenratiomargin",
+		Usage:    "Relative token ratio increase eth_estimateGas prices its estimates with (0 = no margin)",
+		Value:    ethconfig.Defaults.RPCTokenRatioMargin,
+		Category: flags.APICategory,
+	}
 	RPCGlobalLogQueryLimit = &cli.IntFlag{
 		Name:     "rpc.logquerylimit",
 		Usage:    "Maximum number of alternative addresses or topics allowed per search position in eth_getLogs filter criteria (0 = no cap)",
@@ -870,6 +928,16 @@ var (
 		Value:    node.DefaultConfig.BatchResponseMaxSize,
 		Category: flags.APICategory,
 	}
+	RPCAPIKeysFlag = &cli.StringFlag{
+		Name:     "rpc.apikeys",
+		Usage:    "Path to the API key file authenticating the HTTP-RPC and WS-RPC requests",
+		Category: flags.APICategory,
+	}
+	RPCResponseCacheSizeFlag = &cli.IntFlag{
+		Name:     "rpc.response-cache-size",
+		Usage:    "Maximum number of bytes of cached responses on finalized data (0 = disabled)",
+		Category: flags.APICategory,
+	}
 
 	// Network Settings
 	MaxPeersFlag = &cli.IntFlag{
@@ -997,7 +1065,19 @@ var (
 	// Rollup Flags
 	RollupSequencerHTTPFlag = &cli.StringFlag{
 		Name:     "rollup.sequencerhttp",
-		Usage:    "HTTP endpoint for the sequencer mempool",
+		Usage:    "HTTP endpoint for the sequencer mempool, or a comma separated list of endpoints to fail over between",
+		Category: flags.RollupCategory,
+	}
+	RollupSequencerRetriesFlag = &cli.IntFlag{
+		Name:     "rollup.sequencerretries",
+		Usage:    "Number of times a transaction failing to reach the sequencer is retried",
+		Value:    ethconfig.Defaults.RollupSequencerRetries,
+		Category: flags.RollupCategory,
+	}
+	RollupSequencerHealthCheckFlag = &cli.DurationFlag{
+		Name:     "rollup.sequencerhealthcheck",
+		Usage:    "Interval between health checks of the sequencer endpoints (0 = disabled)",
+		Value:    ethconfig.Defaults.RollupSequencerHealthCheck,
 		Category: flags.RollupCategory,
 	}
 
@@ -1013,6 +1093,17 @@ var (
 		Value:    "5s",
 		Category: flags.RollupCategory,
 	}
+	RollupHistoricalRPCMethodTimeoutsFlag = &cli.StringFlag{
+		Name:     "rollup.historicalrpcmethodtimeouts",
+		Usage:    "Comma separated per-method timeouts for historical RPC requests (e.g. debug_traceTransaction=60s,eth_call=10s)",
+		Category: flags.RollupCategory,
+	}
+	RollupHistoricalRPCCacheFlag = &cli.IntFlag{
+		Name:     "rollup.historicalrpccache",
+		Usage:    "Megabytes of memory allocated to caching historical RPC responses (0 = disabled)",
+		Value:    ethconfig.Defaults.RollupHistoricalRPCCache,
+		Category: flags.RollupCategory,
+	}
 
 	RollupDisableTxPoolGossipFlag = &cli.BoolFlag{
 		Name:     "rollup.disabletxpoolgossip",
@@ -1035,6 +1126,22 @@ var (
 		Category: flags.RollupCategory,
 		Value:    true,
 	}
+	RollupEngineRecordFlag = &cli.StringFlag{
+		Name:     "rollup.enginerecord",
+		Usage:    "Directory to record all engine API calls and responses into, for replaying them with 'geth engine-replay'",
+		Category: flags.RollupCategory,
+	}
+	RollupEngineRecordMaxSizeFlag = &cli.IntFlag{
+		Name:     "rollup.enginerecord.maxsize",
+		Usage:    "Maximum size in megabytes of an engine API recording file before it gets rotated",
+		Value:    100,
+		Category: flags.RollupCategory,
+	}
+	RollupEngineRecordMaxBackupsFlag = &cli.IntFlag{
+		Name:     "rollup.enginerecord.maxbackups",
+		Usage:    "Maximum number of rotated engine API recording files to retain (0 = all)",
+		Category: flags.RollupCategory,
+	}
 
 	// Metrics flags
 	MetricsEnabledFlag = &cli.BoolFlag{
@@ -1362,6 +1469,14 @@ func setHTTP(ctx *cli.Context, cfg *node.Config) {
 	if ctx.IsSet(BatchResponseMaxSize.Name) {
 		cfg.BatchResponseMaxSize = ctx.Int(BatchResponseMaxSize.Name)
 	}
+
+	if ctx.IsSet(RPCAPIKeysFlag.Name) {
+		cfg.RPCAPIKeysFile = ctx.String(RPCAPIKeysFlag.Name)
+	}
+
+	if ctx.IsSet(RPCResponseCacheSizeFlag.Name) {
+		cfg.RPCResponseCacheSize = ctx.Int(RPCResponseCacheSizeFlag.Name)
+	}
 }
 
 // setGraphQL creates the GraphQL listener interface string from the set
@@ -1861,6 +1976,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(StateHistoryFlag.Name) {
 		cfg.StateHistory = ctx.Uint64(StateHistoryFlag.Name)
 	}
+	if ctx.IsSet(TrienodeHistoryFlag.Name) {
+		cfg.TrienodeHistory = ctx.Bool(TrienodeHistoryFlag.Name)
+	}
 	if ctx.IsSet(StateSchemeFlag.Name) {
 		cfg.StateScheme = ctx.String(StateSchemeFlag.Name)
 	}
@@ -1891,6 +2009,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(LogExportCheckpointsFlag.Name) {
 		cfg.LogExportCheckpoints = ctx.String(LogExportCheckpointsFlag.Name)
 	}
+	if ctx.IsSet(TraceIndexFlag.Name) {
+		cfg.TraceIndex = ctx.Bool(TraceIndexFlag.Name)
+	}
+	if ctx.IsSet(TraceIndexHistoryFlag.Name) {
+		cfg.TraceIndexHistory = ctx.Uint64(TraceIndexHistoryFlag.Name)
+	}
 	if ctx.IsSet(CacheFlag.Name) || ctx.IsSet(CacheTrieFlag.Name) {
 		cfg.TrieCleanCache = ctx.Int(CacheFlag.Name) * ctx.Int(CacheTrieFlag.Name) / 100
 	}
@@ -1949,6 +2073,19 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(RPCGlobalTxFeeCapFlag.Name) {
 		cfg.RPCTxFeeCap = ctx.Float64(RPCGlobalTxFeeCapFlag.Name)
 	}
+	if ctx.IsSet(RPCBlockRangeLimitFlag.Name) {
+		cfg.RPCBlockRangeLimit = ctx.Uint64(RPCBlockRangeLimitFlag.Name)
+	}
+	if ctx.IsSet(RPCBlockRangeConcurrencyFlag.Name) {
+		cfg.RPCBlockRangeConcurrency = ctx.Int(RPCBlockRangeConcurrencyFlag.Name)
+	}
+	if ctx.IsSet(RPCTokenRatioMarginFlag.Name) {
+		margin := ctx.Float64(RPCTokenRatioMarginFlag.Name)
+		if margin < 0 {
+			Fatalf("Invalid token ratio margin %v, must not be negative", margin)
+		}
+		cfg.RPCTokenRatioMargin = margin
+	}
 	if ctx.IsSet(NoDiscoverFlag.Name) {
 		cfg.EthDiscoveryURLs, cfg.SnapDiscoveryURLs = []string{}, []string{}
 	} else if ctx.IsSet(DNSDiscoveryFlag.Name) {
@@ -1967,12 +2104,35 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(RollupSequencerHTTPFlag.Name) && !ctx.IsSet(MiningEnabledFlag.Name) {
 		cfg.RollupSequencerHTTP = ctx.String(RollupSequencerHTTPFlag.Name)
 	}
+	if ctx.IsSet(RollupSequencerRetriesFlag.Name) {
+		cfg.RollupSequencerRetries = ctx.Int(RollupSequencerRetriesFlag.Name)
+	}
+	if ctx.IsSet(RollupSequencerHealthCheckFlag.Name) {
+		cfg.RollupSequencerHealthCheck = ctx.Duration(RollupSequencerHealthCheckFlag.Name)
+	}
 	if ctx.IsSet(RollupHistoricalRPCFlag.Name) {
 		cfg.RollupHistoricalRPC = ctx.String(RollupHistoricalRPCFlag.Name)
 	}
 	if ctx.IsSet(RollupHistoricalRPCTimeoutFlag.Name) {
 		cfg.RollupHistoricalRPCTimeout = ctx.Duration(RollupHistoricalRPCTimeoutFlag.Name)
 	}
+	if ctx.IsSet(RollupHistoricalRPCMethodTimeoutsFlag.Name) {
+		cfg.RollupHistoricalRPCMethodTimeouts = make(map[string]time.Duration)
+		for _, entry := range SplitAndTrim(ctx.String(RollupHistoricalRPCMethodTimeoutsFlag.Name)) {
+			method, value, ok := strings.Cut(entry, "=")
+			if !ok {
+				Fatalf("Invalid historical RPC method timeout %q, expected <method>=<duration>", entry)
+			}
+			timeout, err := time.ParseDuration(value)
+			if err != nil {
+				Fatalf("Invalid historical RPC timeout of method %s: %v", method, err)
+			}
+			cfg.RollupHistoricalRPCMethodTimeouts[method] = timeout
+		}
+	}
+	if ctx.IsSet(RollupHistoricalRPCCacheFlag.Name) {
+		cfg.RollupHistoricalRPCCache = ctx.Int(RollupHistoricalRPCCacheFlag.Name)
+	}
 	cfg.RollupDisableTxPoolGossip = ctx.Bool(RollupDisableTxPoolGossipFlag.Name)
 	cfg.RollupDisableTxPoolAdmission = cfg.RollupSequencerHTTP != "" && !ctx.Bool(RollupEnableTxPoolAdmissionFlag.Name)
 	cfg.ApplyMantleUpgrades = ctx.Bool(RollupMantleUpgradesFlag.Name)
@@ -2049,7 +2209,11 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 
 		// configure default developer genesis which will be used unless a
 		// datadir is specified and a chain is preexisting at that location.
-		cfg.Genesis = core.DeveloperGenesisBlock(ctx.Uint64(DeveloperGasLimitFlag.Name), &developer.Address)
+		if ctx.Bool(DeveloperRollupFlag.Name) {
+			cfg.Genesis = core.DeveloperRollupGenesisBlock(ctx.Uint64(DeveloperGasLimitFlag.Name), &developer.Address)
+		} else {
+			cfg.Genesis = core.DeveloperGenesisBlock(ctx.Uint64(DeveloperGasLimitFlag.Name), &developer.Address)
+		}
 
 		// If a datadir is specified, ensure that any preexisting chain in that location
 		// has a configuration that is compatible with dev mode: it must be merged at genesis.
@@ -2221,7 +2385,12 @@ func RegisterEthService(stack *node.Node, cfg *ethconfig.Config) (*eth.EthAPIBac
 	if err != nil {
 		Fatalf("Failed to register the Ethereum service: %v", err)
 	}
-	stack.RegisterAPIs(tracers.APIs(backend.APIBackend))
+	var indexer *tracers.TraceIndexer
+	if cfg.TraceIndex {
+		indexer = tracers.NewTraceIndexer(backend.APIBackend, cfg.TraceIndexHistory)
+		stack.RegisterLifecycle(indexer)
+	}
+	stack.RegisterAPIs(tracers.APIs(backend.APIBackend, indexer))
 	return backend.APIBackend, backend
 }
 
@@ -2447,15 +2616,16 @@ func MakeChain(ctx *cli.Context, stack *node.Node, readonly bool) (*core.BlockCh
 		Fatalf("%v", err)
 	}
 	options := &core.BlockChainConfig{
-		TrieCleanLimit: ethconfig.Defaults.TrieCleanCache,
-		NoPrefetch:     ctx.Bool(CacheNoPrefetchFlag.Name),
-		TrieDirtyLimit: ethconfig.Defaults.TrieDirtyCache,
-		ArchiveMode:    ctx.String(GCModeFlag.Name) == "archive",
-		TrieTimeLimit:  ethconfig.Defaults.TrieTimeout,
-		SnapshotLimit:  ethconfig.Defaults.SnapshotCache,
-		Preimages:      ctx.Bool(CachePreimagesFlag.Name),
-		StateScheme:    scheme,
-		StateHistory:   ctx.Uint64(StateHistoryFlag.Name),
+		TrieCleanLimit:  ethconfig.Defaults.TrieCleanCache,
+		NoPrefetch:      ctx.Bool(CacheNoPrefetchFlag.Name),
+		TrieDirtyLimit:  ethconfig.Defaults.TrieDirtyCache,
+		ArchiveMode:     ctx.String(GCModeFlag.Name) == "archive",
+		TrieTimeLimit:   ethconfig.Defaults.TrieTimeout,
+		SnapshotLimit:   ethconfig.Defaults.SnapshotCache,
+		Preimages:       ctx.Bool(CachePreimagesFlag.Name),
+		StateScheme:     scheme,
+		StateHistory:    ctx.Uint64(StateHistoryFlag.Name),
+		TrienodeHistory: ctx.Bool(TrienodeHistoryFlag.Name),
 		// Disable transaction indexing/unindexing.
 		TxLookupLimit: -1,
 
@@ -2553,6 +2723,10 @@ func MakeTrieDatabase(ctx *cli.Context, stack *node.Node, disk ethdb.Database, p
 		pathConfig = *pathdb.Defaults
 	}
 	pathConfig.JournalDirectory = stack.ResolvePath("triedb")
+	pathConfig.TrienodeHistory = ctx.Bool(TrienodeHistoryFlag.Name)
+	if ctx.IsSet(StateHistoryFlag.Name) {
+		pathConfig.StateHistory = ctx.Uint64(StateHistoryFlag.Name)
+	}
 	config.PathDB = &pathConfig
 	return triedb.NewDatabase(disk, config)
 }
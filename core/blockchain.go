@@ -177,6 +177,10 @@ type BlockChainConfig struct {
 	// If set to 0, all state histories across the entire chain will be retained;
 	StateHistory uint64
 
+	// Whether the trie node histories are retained along with the state histories,
+	// which enables the proof generation of historical states.
+	TrienodeHistory bool
+
 	// State snapshot related options
 	SnapshotLimit   int  // Memory allowance (MB) to use for caching snapshot entries in memory
 	SnapshotNoBuild bool // Whether the background generation is allowed
@@ -200,6 +204,11 @@ type BlockChainConfig struct {
 
 	// StateSizeTracking indicates whether the state size tracking is enabled.
 	StateSizeTracking bool
+
+	// OnRewind is called after the chain has been rewound, e.g. by SetHead or
+	// the repair on startup, to drop the data cached outside of the chain for
+	// the removed blocks.
+	OnRewind func()
 }
 
 // DefaultConfig returns the default config.
@@ -252,6 +261,7 @@ func (cfg *BlockChainConfig) triedbConfig(isVerkle bool) *triedb.Config {
 		config.PathDB = &pathdb.Config{
 			StateHistory:        cfg.StateHistory,
 			EnableStateIndexing: cfg.ArchiveMode,
+			TrienodeHistory:     cfg.TrienodeHistory,
 			TrieCleanSize:       cfg.TrieCleanLimit * 1024 * 1024,
 			StateCleanSize:      cfg.SnapshotLimit * 1024 * 1024,
 			JournalDirectory:    cfg.TrieJournalDirectory,
@@ -1112,7 +1122,13 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, time uint64, root common.Ha
 		log.Error("SetHead invalidated finalized block")
 		bc.SetFinalized(nil)
 	}
-	return rootNumber, bc.loadLastState()
+	if err := bc.loadLastState(); err != nil {
+		return rootNumber, err
+	}
+	if bc.cfg.OnRewind != nil {
+		bc.cfg.OnRewind()
+	}
+	return rootNumber, nil
 }
 
 // SnapSyncCommitHead sets the current head block to the one defined by the hash
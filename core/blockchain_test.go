@@ -3491,6 +3491,39 @@ func testSetCanonical(t *testing.T, scheme string) {
 	verify(canon[chainLength-1])
 }
 
+// Tests that the rewind hook is called when the chain is rewound.
+func TestSetHeadRewindHook(t *testing.T) {
+	var (
+		gspec   = &Genesis{Config: params.TestChainConfig, BaseFee: big.NewInt(params.InitialBaseFee)}
+		engine  = ethash.NewFaker()
+		options = DefaultConfig()
+		rewinds int
+	)
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, 4, nil)
+	options.OnRewind = func() { rewinds++ }
+	chain, err := NewBlockChain(rawdb.NewMemoryDatabase(), gspec, engine, options)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	if rewinds != 0 {
+		t.Fatalf("rewind hook called %d times on import", rewinds)
+	}
+	if err := chain.SetHead(2); err != nil {
+		t.Fatalf("failed to rewind chain: %v", err)
+	}
+	if err := chain.Reset(); err != nil {
+		t.Fatalf("failed to reset chain: %v", err)
+	}
+	if rewinds != 2 {
+		t.Fatalf("rewind hook called %d times, want 2", rewinds)
+	}
+}
+
 // TestCanonicalHashMarker tests all the canonical hash markers are updated/deleted
 // correctly in case reorg is called.
 func TestCanonicalHashMarker(t *testing.T) {
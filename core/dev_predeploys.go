@@ -0,0 +1,149 @@
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/program"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Initial L1 fee parameters of the dev rollup chain.
+var (
+	devL1BaseFee  = big.NewInt(1_000_000_000)
+	devOverhead   = big.NewInt(188)
+	devScalar     = big.NewInt(684_000)
+	devTokenRatio = big.NewInt(1)
+)
+
+// Storage slots of the owner and the operator of the GasPriceOracle, following
+// the tokenRatio in slot 0.
+var (
+	gasOracleOwnerSlot    = common.BigToHash(big.NewInt(1))
+	gasOracleOperatorSlot = common.BigToHash(big.NewInt(2))
+)
+
+// devRollupPredeploys returns stand-ins for the L1Block, GasPriceOracle and
+// BVM_ETH predeploys. They only implement the storage layout read by the L1
+// cost function and the BVM_ETH balance updates, the getters of the stored
+// values, and the access control of the setters of the real predeploys:
+//
+//   - L1Block stores the arguments of setL1BlockValues(uint64 number,
+//     uint64 timestamp, uint256 basefee, bytes32 hash, uint64 sequenceNumber,
+//     bytes32 batcherHash, uint256 l1FeeOverhead, uint256 l1FeeScalar) into
+//     slots 0 to 6, with number and timestamp packed into slot 0. Only the L1
+//     info depositor may call it.
+//   - GasPriceOracle stores the argument of setTokenRatio(uint256) into slot 0,
+//     which only the operator in slot 2 may call. The operator is initially the
+//     L1 info depositor, and may be replaced by the owner in slot 1 through
+//     setOperator(address). The owner is the given account, if any.
+//   - BVM_ETH only serves balanceOf(address) and totalSupply() from the
+//     storage written when BVM_ETH is minted by deposits.
+func devRollupPredeploys(owner *common.Address) types.GenesisAlloc {
+	l1Block := program.New()
+	for slot, getter := range []string{"", "basefee()", "hash()", "sequenceNumber()", "batcherHash()", "l1FeeOverhead()", "l1FeeScalar()"} {
+		if getter != "" {
+			dispatch(l1Block, getter, returnSlot(slot))
+		}
+	}
+	l1Block.Append(onlyCaller(program.New().Push(types.L1InfoDepositerAddress).Bytes()))
+	l1Block.Push(36).Op(vm.CALLDATALOAD).Push(64).Op(vm.SHL)
+	l1Block.Push(4).Op(vm.CALLDATALOAD).Op(vm.OR).Push(0).Op(vm.SSTORE)
+	for slot := 1; slot <= 6; slot++ {
+		l1Block.Push(4 + 32*(slot+1)).Op(vm.CALLDATALOAD).Push(slot).Op(vm.SSTORE)
+	}
+	l1Block.Op(vm.STOP)
+
+	gasOracle := program.New()
+	dispatch(gasOracle, "tokenRatio()", returnSlot(0))
+	dispatch(gasOracle, "owner()", returnSlot(1))
+	dispatch(gasOracle, "operator()", returnSlot(2))
+	dispatch(gasOracle, "setTokenRatio(uint256)", storeArgument(2, 0))
+	dispatch(gasOracle, "setOperator(address)", storeArgument(1, 2))
+	gasOracle.Push(0).Op(vm.DUP1, vm.REVERT)
+
+	gasOracleStorage := map[common.Hash]common.Hash{
+		types.TokenRatioSlot:  common.BigToHash(devTokenRatio),
+		gasOracleOperatorSlot: common.BytesToHash(types.L1InfoDepositerAddress.Bytes()),
+	}
+	if owner != nil {
+		gasOracleStorage[gasOracleOwnerSlot] = common.BytesToHash(owner.Bytes())
+	}
+
+	// The balances mapping lives in slot 0 and the total supply in slot 2, see
+	// getBVMETHBalanceKey and getBVMETHTotalSupplyKey.
+	balanceOf := program.New()
+	balanceOf.Push(4).Op(vm.CALLDATALOAD).Push(0).Op(vm.MSTORE)
+	balanceOf.Push(0).Push(32).Op(vm.MSTORE)
+	balanceOf.Push(64).Push(0).Op(vm.KECCAK256, vm.SLOAD).Push(0).Op(vm.MSTORE)
+	balanceOf.Return(0, 32)
+
+	bvmETH := program.New()
+	dispatch(bvmETH, "balanceOf(address)", balanceOf.Bytes())
+	dispatch(bvmETH, "totalSupply()", returnSlot(2))
+	bvmETH.Push(0).Op(vm.DUP1, vm.REVERT)
+
+	return types.GenesisAlloc{
+		types.L1BlockAddr: {
+			Code:    l1Block.Bytes(),
+			Balance: common.Big0,
+			Storage: map[common.Hash]common.Hash{
+				types.L1BaseFeeSlot: common.BigToHash(devL1BaseFee),
+				types.OverheadSlot:  common.BigToHash(devOverhead),
+				types.ScalarSlot:    common.BigToHash(devScalar),
+			},
+		},
+		types.GasOracleAddr: {
+			Code:    gasOracle.Bytes(),
+			Balance: common.Big0,
+			Storage: gasOracleStorage,
+		},
+		BVM_ETH_ADDR: {
+			Code:    bvmETH.Bytes(),
+			Balance: common.Big0,
+		},
+	}
+}
+
+// onlyCaller returns a guard reverting the execution if the caller is not the
+// address pushed by load. The guard jumps relative to the program counter, so
+// it can be placed anywhere, including the body of a dispatched function.
+func onlyCaller(load []byte) []byte {
+	p := program.New().Op(vm.CALLER).Append(load).Op(vm.EQ)
+	// PC, PUSH1 9, ADD, JUMPI, PUSH1 0, DUP1, REVERT, JUMPDEST
+	p.Op(vm.PC).Push(9).Op(vm.ADD, vm.JUMPI)
+	p.Push(0).Op(vm.DUP1, vm.REVERT)
+	p.Jumpdest()
+	return p.Bytes()
+}
+
+// storeArgument returns code storing the first call argument into the given
+// slot, if the caller is the address stored in the guard slot.
+func storeArgument(guard int, slot int) []byte {
+	p := program.New().Append(onlyCaller(program.New().Push(guard).Op(vm.SLOAD).Bytes()))
+	return p.Push(4).Op(vm.CALLDATALOAD).Push(slot).Op(vm.SSTORE, vm.STOP).Bytes()
+}
+
+// dispatch appends a branch executing body if the call matches the function
+// with the given signature.
+func dispatch(p *program.Program, signature string, body []byte) {
+	p.Push(0).Op(vm.CALLDATALOAD).Push(224).Op(vm.SHR)
+	p.Push(crypto.Keccak256([]byte(signature))[:4]).Op(vm.EQ, vm.ISZERO)
+	// PUSH2 dest, JUMPI, body
+	jumpi(p, p.Size()+4+len(body))
+	p.Append(body)
+	p.Jumpdest()
+}
+
+// jumpi appends a conditional jump to dest. The destination is always pushed
+// with PUSH2, so that the size of the jump does not depend on dest.
+func jumpi(p *program.Program, dest int) {
+	p.Append([]byte{byte(vm.PUSH2), byte(dest >> 8), byte(dest)}).Op(vm.JUMPI)
+}
+
+// returnSlot returns code returning the value stored in the given slot.
+func returnSlot(slot int) []byte {
+	return program.New().Push(slot).Op(vm.SLOAD).Push(0).Op(vm.MSTORE).Return(0, 32).Bytes()
+}
@@ -282,7 +282,13 @@ type ChainOverrides struct {
 	OverrideOptimismBedrock  *big.Int
 	OverrideOptimismRegolith *uint64
 	OverrideOptimism         *bool
-	ApplyMantleUpgrades      bool
+
+	// mantle
+	ApplyMantleUpgrades   bool
+	MantleUpgradeConfig   *params.MantleUpgradeChainConfig
+	OverrideMantleEverest *uint64
+	OverrideMantleSkadi   *uint64
+	OverrideMantleLimb    *uint64
 }
 
 // apply applies the chain overrides on the supplied chain config.
@@ -320,8 +326,23 @@ func (o *ChainOverrides) apply(cfg *params.ChainConfig) error {
 	}
 
 	// mantle
-	mantleUpgradeChainConfig := params.GetUpgradeConfigForMantle(cfg.ChainID)
-	if o.ApplyMantleUpgrades && mantleUpgradeChainConfig != nil {
+	mantleUpgradeChainConfig, err := o.mantleUpgradeConfig(cfg)
+	if err != nil {
+		return err
+	}
+	if !o.ApplyMantleUpgrades {
+		if o.OverrideMantleEverest != nil {
+			cfg.MantleEverestTime = o.OverrideMantleEverest
+		}
+		if o.OverrideMantleSkadi != nil {
+			cfg.MantleSkadiTime = o.OverrideMantleSkadi
+			setMantleSkadiEVMForks(cfg, o.OverrideMantleSkadi)
+		}
+		if o.OverrideMantleLimb != nil {
+			cfg.MantleLimbTime = o.OverrideMantleLimb
+			setMantleLimbEVMForks(cfg, o.OverrideMantleLimb)
+		}
+	} else if mantleUpgradeChainConfig != nil {
 		cfg.BaseFeeTime = mantleUpgradeChainConfig.BaseFeeTime
 		cfg.BVMETHMintUpgradeTime = mantleUpgradeChainConfig.BVMETHMintUpgradeTime
 		cfg.MetaTxV2UpgradeTime = mantleUpgradeChainConfig.MetaTxV2UpgradeTime
@@ -331,17 +352,65 @@ func (o *ChainOverrides) apply(cfg *params.ChainConfig) error {
 		cfg.MantleSkadiTime = mantleUpgradeChainConfig.MantleSkadiTime
 		cfg.MantleLimbTime = mantleUpgradeChainConfig.MantleLimbTime
 
-		// active standard EVM version (shanghai/cancun/prague)  in mantle skadi time
-		cfg.ShanghaiTime = mantleUpgradeChainConfig.MantleSkadiTime
-		cfg.CancunTime = mantleUpgradeChainConfig.MantleSkadiTime
-		cfg.PragueTime = mantleUpgradeChainConfig.MantleSkadiTime
-		// active standard EVM version (osaka)  in mantle limb time
-		cfg.OsakaTime = mantleUpgradeChainConfig.MantleLimbTime
+		setMantleSkadiEVMForks(cfg, mantleUpgradeChainConfig.MantleSkadiTime)
+		setMantleLimbEVMForks(cfg, mantleUpgradeChainConfig.MantleLimbTime)
 	}
 
 	return cfg.CheckConfigForkOrder()
 }
 
+// setMantleSkadiEVMForks activates the standard EVM versions shipped with the
+// Mantle Skadi upgrade (shanghai/cancun/prague) at the given time.
+func setMantleSkadiEVMForks(cfg *params.ChainConfig, time *uint64) {
+	cfg.ShanghaiTime = time
+	cfg.CancunTime = time
+	cfg.PragueTime = time
+}
+
+// setMantleLimbEVMForks activates the standard EVM version shipped with the
+// Mantle Limb upgrade (osaka) at the given time.
+func setMantleLimbEVMForks(cfg *params.ChainConfig, time *uint64) {
+	cfg.OsakaTime = time
+}
+
+// mantleUpgradeConfig resolves the Mantle upgrade schedule for the supplied
+// chain config, with the fork overrides applied. An explicitly configured
+// schedule takes precedence. Chains without a bundled schedule keep the fork
+// times defined in their genesis, and only fall back to the default schedule
+// if none are defined.
+func (o *ChainOverrides) mantleUpgradeConfig(cfg *params.ChainConfig) (*params.MantleUpgradeChainConfig, error) {
+	var upgrades *params.MantleUpgradeChainConfig
+	switch {
+	case o.MantleUpgradeConfig != nil:
+		if o.MantleUpgradeConfig.ChainID != nil && cfg.ChainID != nil && o.MantleUpgradeConfig.ChainID.Cmp(cfg.ChainID) != 0 {
+			return nil, fmt.Errorf("mantle upgrade config chain ID mismatch: have %v, want %v", o.MantleUpgradeConfig.ChainID, cfg.ChainID)
+		}
+		upgrades = o.MantleUpgradeConfig.Copy()
+	case !params.IsBundledMantleChain(cfg.ChainID):
+		if upgrades = params.MantleUpgradeConfigFromChainConfig(cfg); upgrades != nil {
+			break
+		}
+		fallthrough
+	default:
+		if bundled := params.GetUpgradeConfigForMantle(cfg.ChainID); bundled != nil {
+			upgrades = bundled.Copy()
+		}
+	}
+	if upgrades == nil {
+		return nil, nil
+	}
+	if o.OverrideMantleEverest != nil {
+		upgrades.MantleEverestTime = o.OverrideMantleEverest
+	}
+	if o.OverrideMantleSkadi != nil {
+		upgrades.MantleSkadiTime = o.OverrideMantleSkadi
+	}
+	if o.OverrideMantleLimb != nil {
+		upgrades.MantleLimbTime = o.OverrideMantleLimb
+	}
+	return upgrades, nil
+}
+
 // SetupGenesisBlock writes or updates the genesis block in db.
 // The block that will be used is:
 //
@@ -765,6 +834,40 @@ func DeveloperGenesisBlock(gasLimit uint64, faucet *common.Address) *Genesis {
 	return genesis
 }
 
+// DeveloperRollupGenesisBlock returns the genesis block of an OP-stack style
+// dev chain with all Mantle upgrades active. In addition to the developer
+// genesis allocation, it pre-deploys minimal L1Block and GasPriceOracle
+// contracts that accept the L1 attributes deposits of the simulated sequencer.
+// The faucet, if any, owns the GasPriceOracle.
+func DeveloperRollupGenesisBlock(gasLimit uint64, faucet *common.Address) *Genesis {
+	genesis := DeveloperGenesisBlock(gasLimit, faucet)
+
+	config := *genesis.Config
+	config.BlobScheduleConfig = nil
+	config.BedrockBlock = big.NewInt(0)
+	config.RegolithTime = new(uint64)
+	config.Optimism = &params.OptimismConfig{
+		EIP1559Elasticity:  2,
+		EIP1559Denominator: 50,
+	}
+	upgrades := params.MantleLocalUpgradeConfig.Copy()
+	config.BaseFeeTime = upgrades.BaseFeeTime
+	config.BVMETHMintUpgradeTime = upgrades.BVMETHMintUpgradeTime
+	config.MetaTxV2UpgradeTime = upgrades.MetaTxV2UpgradeTime
+	config.MetaTxV3UpgradeTime = upgrades.MetaTxV3UpgradeTime
+	config.ProxyOwnerUpgradeTime = upgrades.ProxyOwnerUpgradeTime
+	config.MantleEverestTime = upgrades.MantleEverestTime
+	config.MantleSkadiTime = upgrades.MantleSkadiTime
+	config.MantleLimbTime = upgrades.MantleLimbTime
+	config.OsakaTime = upgrades.MantleLimbTime
+	genesis.Config = &config
+
+	for addr, account := range devRollupPredeploys(faucet) {
+		genesis.Alloc[addr] = account
+	}
+	return genesis
+}
+
 func decodePrealloc(data string) types.GenesisAlloc {
 	var p []struct {
 		Addr    *big.Int
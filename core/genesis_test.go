@@ -21,13 +21,17 @@ import (
 	"encoding/json"
 	"math/big"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/triedb"
@@ -340,3 +344,124 @@ func TestVerkleGenesisCommit(t *testing.T) {
 		t.Fatal("could not find node")
 	}
 }
+
+func TestMantleChainOverrides(t *testing.T) {
+	newConfig := func(chainID *big.Int) *params.ChainConfig {
+		conf := *params.OptimismTestConfig
+		conf.ChainID = chainID
+		return &conf
+	}
+	u64 := func(v uint64) *uint64 { return &v }
+
+	// Bundled networks use the shipped schedule, and the overrides are applied
+	// on top without touching the bundled config.
+	cfg := newConfig(params.MantleMainnetChainId)
+	overrides := &ChainOverrides{ApplyMantleUpgrades: true, OverrideMantleLimb: u64(1_800_000_000)}
+	if err := overrides.apply(cfg); err != nil {
+		t.Fatalf("failed to apply overrides: %v", err)
+	}
+	if *cfg.MantleSkadiTime != *params.MantleMainnetUpgradeConfig.MantleSkadiTime {
+		t.Errorf("wrong skadi time: have %d, want %d", *cfg.MantleSkadiTime, *params.MantleMainnetUpgradeConfig.MantleSkadiTime)
+	}
+	if *cfg.MantleLimbTime != 1_800_000_000 || *cfg.OsakaTime != 1_800_000_000 {
+		t.Errorf("limb override not applied: limb %v, osaka %v", cfg.MantleLimbTime, cfg.OsakaTime)
+	}
+	if params.MantleMainnetUpgradeConfig.MantleLimbTime != nil {
+		t.Errorf("bundled mainnet config modified")
+	}
+
+	// Unknown chains keep the schedule defined in their genesis.
+	cfg = newConfig(big.NewInt(424242))
+	cfg.MantleEverestTime, cfg.MantleSkadiTime = u64(0), u64(100)
+	if err := (&ChainOverrides{ApplyMantleUpgrades: true}).apply(cfg); err != nil {
+		t.Fatalf("failed to apply overrides: %v", err)
+	}
+	if *cfg.MantleSkadiTime != 100 || *cfg.PragueTime != 100 || cfg.MantleLimbTime != nil {
+		t.Errorf("genesis schedule not retained: skadi %v, prague %v, limb %v", cfg.MantleSkadiTime, cfg.PragueTime, cfg.MantleLimbTime)
+	}
+
+	// An explicit schedule takes precedence, but must match the chain.
+	custom := &params.MantleUpgradeChainConfig{ChainID: big.NewInt(424242), MantleEverestTime: u64(0), MantleSkadiTime: u64(50), MantleLimbTime: u64(60)}
+	cfg = newConfig(big.NewInt(424242))
+	if err := (&ChainOverrides{ApplyMantleUpgrades: true, MantleUpgradeConfig: custom}).apply(cfg); err != nil {
+		t.Fatalf("failed to apply overrides: %v", err)
+	}
+	if *cfg.MantleSkadiTime != 50 || *cfg.MantleLimbTime != 60 {
+		t.Errorf("custom schedule not applied: skadi %v, limb %v", cfg.MantleSkadiTime, cfg.MantleLimbTime)
+	}
+	if err := (&ChainOverrides{ApplyMantleUpgrades: true, MantleUpgradeConfig: custom}).apply(newConfig(big.NewInt(1))); err == nil {
+		t.Errorf("expected chain ID mismatch error")
+	}
+
+	// Without the upgrade schedule, the overrides still move the EVM forks
+	// along with the Mantle forks.
+	cfg = newConfig(big.NewInt(424242))
+	cfg.MantleEverestTime, cfg.MantleSkadiTime = u64(0), u64(100)
+	cfg.ShanghaiTime, cfg.CancunTime, cfg.PragueTime = u64(100), u64(100), u64(100)
+	if err := (&ChainOverrides{OverrideMantleSkadi: u64(200), OverrideMantleLimb: u64(300)}).apply(cfg); err != nil {
+		t.Fatalf("failed to apply overrides: %v", err)
+	}
+	if *cfg.MantleSkadiTime != 200 || *cfg.ShanghaiTime != 200 || *cfg.CancunTime != 200 || *cfg.PragueTime != 200 {
+		t.Errorf("skadi override not aligned: skadi %v, shanghai %v, cancun %v, prague %v", cfg.MantleSkadiTime, cfg.ShanghaiTime, cfg.CancunTime, cfg.PragueTime)
+	}
+	if *cfg.MantleLimbTime != 300 || *cfg.OsakaTime != 300 {
+		t.Errorf("limb override not aligned: limb %v, osaka %v", cfg.MantleLimbTime, cfg.OsakaTime)
+	}
+
+	// Misordered overrides are rejected.
+	if err := (&ChainOverrides{ApplyMantleUpgrades: true, OverrideMantleSkadi: u64(2_000_000_000)}).apply(newConfig(params.MantleSepoliaChainId)); err == nil {
+		t.Errorf("expected fork ordering error")
+	}
+}
+
+// TestDevRollupGasPriceOracle checks that the dev GasPriceOracle only accepts
+// tokenRatio updates from its operator, which only the owner may replace.
+func TestDevRollupGasPriceOracle(t *testing.T) {
+	var (
+		owner    = common.Address{0x01}
+		operator = common.Address{0x02}
+		gspec    = DeveloperRollupGenesisBlock(30_000_000, &owner)
+		engine   = beacon.New(ethash.NewFaker())
+		calls    = []struct {
+			from common.Address
+			sig  string
+			arg  common.Hash
+			ok   bool
+		}{
+			{owner, "setTokenRatio(uint256)", common.BigToHash(big.NewInt(5)), false},
+			{types.L1InfoDepositerAddress, "setTokenRatio(uint256)", common.BigToHash(big.NewInt(6)), true},
+			{operator, "setOperator(address)", common.BytesToHash(operator.Bytes()), false},
+			{owner, "setOperator(address)", common.BytesToHash(operator.Bytes()), true},
+			{types.L1InfoDepositerAddress, "setTokenRatio(uint256)", common.BigToHash(big.NewInt(8)), false},
+			{operator, "setTokenRatio(uint256)", common.BigToHash(big.NewInt(7)), true},
+		}
+	)
+	db, blocks, receipts := GenerateChainWithGenesis(gspec, engine, 1, func(i int, b *BlockGen) {
+		b.SetParentBeaconRoot(common.Hash{byte(i + 1)})
+		for j, call := range calls {
+			b.AddTx(types.NewTx(&types.DepositTx{
+				SourceHash: common.Hash{byte(j + 1)},
+				From:       call.from,
+				To:         &types.GasOracleAddr,
+				Value:      new(big.Int),
+				Gas:        100_000,
+				Data:       slices.Concat(crypto.Keccak256([]byte(call.sig))[:4], call.arg.Bytes()),
+			}))
+		}
+	})
+	for i, call := range calls {
+		if ok := receipts[0][i].Status == types.ReceiptStatusSuccessful; ok != call.ok {
+			t.Errorf("call %d: %s from %x: have success %v, want %v", i, call.sig, call.from, ok, call.ok)
+		}
+	}
+	statedb, err := state.New(blocks[0].Root(), state.NewDatabase(triedb.NewDatabase(db, triedb.HashDefaults), nil))
+	if err != nil {
+		t.Fatalf("failed to open state: %v", err)
+	}
+	if ratio := statedb.GetState(types.GasOracleAddr, types.TokenRatioSlot).Big(); ratio.Uint64() != 7 {
+		t.Errorf("have token ratio %v, want 7", ratio)
+	}
+	if have := common.BytesToAddress(statedb.GetState(types.GasOracleAddr, gasOracleOperatorSlot).Bytes()); have != operator {
+		t.Errorf("have operator %x, want %x", have, operator)
+	}
+}
@@ -327,6 +327,67 @@ func TestResettableAncientSuite(t *testing.T, newFn func(kinds []string) ethdb.R
 			t.Fatalf("Failed to write ancient data %v", err)
 		}
 	})
+	t.Run("ResetTo", func(t *testing.T) {
+		var (
+			db   = newFn([]string{"a", "b"})
+			data = makeDataset(100, 32)
+		)
+		defer db.Close()
+
+		if err := db.ResetTo(50); err != nil {
+			t.Fatalf("Failed to reset ancient store %v", err)
+		}
+		if tail, _ := db.Tail(); tail != 50 {
+			t.Fatalf("Unexpected tail, want: %d, got: %d", 50, tail)
+		}
+		if head, _ := db.Ancients(); head != 50 {
+			t.Fatalf("Unexpected head, want: %d, got: %d", 50, head)
+		}
+		// Items below the tail are not writable
+		if _, err := db.ModifyAncients(func(op ethdb.AncientWriteOp) error {
+			return op.AppendRaw("a", 0, data[0])
+		}); err == nil {
+			t.Fatal("Write below the tail is not expected to succeed")
+		}
+		if _, err := db.ModifyAncients(func(op ethdb.AncientWriteOp) error {
+			for i := 50; i < 100; i++ {
+				if err := op.AppendRaw("a", uint64(i), data[i]); err != nil {
+					return err
+				}
+				if err := op.AppendRaw("b", uint64(i), data[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("Failed to write ancient data %v", err)
+		}
+		if head, _ := db.Ancients(); head != 100 {
+			t.Fatalf("Unexpected head, want: %d, got: %d", 100, head)
+		}
+		for i := 50; i < 100; i++ {
+			blob, err := db.Ancient("a", uint64(i))
+			if err != nil {
+				t.Fatalf("Failed to read ancient data %v", err)
+			}
+			if !bytes.Equal(blob, data[i]) {
+				t.Fatalf("Unexpected ancient data, want: %x, got: %x", data[i], blob)
+			}
+		}
+		if _, err := db.Ancient("a", 49); err == nil {
+			t.Fatal("Item below the tail is not expected to be readable")
+		}
+		// Resetting back to zero should drop everything
+		if err := db.ResetTo(0); err != nil {
+			t.Fatalf("Failed to reset ancient store %v", err)
+		}
+		if tail, _ := db.Tail(); tail != 0 {
+			t.Fatalf("Unexpected tail, want: %d, got: %d", 0, tail)
+		}
+		if head, _ := db.Ancients(); head != 0 {
+			t.Fatalf("Unexpected head, want: %d, got: %d", 0, head)
+		}
+	})
 }
 
 func makeDataset(size, value int) [][]byte {
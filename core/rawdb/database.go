@@ -426,6 +426,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		filterMapRows      stat
 		filterMapLastBlock stat
 		filterMapBlockLV   stat
+		traceIndex         stat
 
 		// Path-mode archive data
 		stateIndex stat
@@ -522,6 +523,10 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			case bytes.HasPrefix(key, bloomBitsMetaPrefix) && len(key) < len(bloomBitsMetaPrefix)+8:
 				bloomBits.add(size)
 
+			// call trace index
+			case bytes.HasPrefix(key, TraceIndexPrefix):
+				traceIndex.add(size)
+
 			// Path-based historic state indexes
 			case bytes.HasPrefix(key, StateHistoryIndexPrefix) && len(key) >= len(StateHistoryIndexPrefix)+common.HashLength:
 				stateIndex.add(size)
@@ -616,6 +621,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		{"Key-Value store", "Log index last-block-of-map", filterMapLastBlock.sizeString(), filterMapLastBlock.countString()},
 		{"Key-Value store", "Log index block-lv", filterMapBlockLV.sizeString(), filterMapBlockLV.countString()},
 		{"Key-Value store", "Log bloombits (deprecated)", bloomBits.sizeString(), bloomBits.countString()},
+		{"Key-Value store", "Call trace index", traceIndex.sizeString(), traceIndex.countString()},
 		{"Key-Value store", "Contract codes", codes.sizeString(), codes.countString()},
 		{"Key-Value store", "Hash trie nodes", legacyTries.sizeString(), legacyTries.countString()},
 		{"Key-Value store", "Path trie state lookups", stateLookups.sizeString(), stateLookups.countString()},
@@ -395,15 +395,25 @@ func (f *MemoryFreezer) Close() error {
 // Reset drops all the data cached in the memory freezer and reset itself
 // back to default state.
 func (f *MemoryFreezer) Reset() error {
+	return f.ResetTo(0)
+}
+
+// ResetTo drops all the data cached in the memory freezer and reset itself
+// to an empty state with the first item positioned at the given number.
+func (f *MemoryFreezer) ResetTo(tail uint64) error {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
 	tables := make(map[string]*memoryTable)
 	for name, table := range f.tables {
+		if tail != 0 && !table.config.prunable {
+			return fmt.Errorf("non-prunable freezer table %s can't start at %d", name, tail)
+		}
 		tables[name] = newMemoryTable(name, table.config)
+		tables[name].items, tables[name].offset = tail, tail
 	}
 	f.tables = tables
-	f.items, f.tail = 0, 0
+	f.items, f.tail = tail, tail
 	return nil
 }
 
@@ -17,6 +17,7 @@
 package rawdb
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
@@ -37,6 +38,7 @@ type resettableFreezer struct {
 	freezer  *Freezer
 	opener   freezerOpenFunc
 	datadir  string
+	tables   map[string]freezerTableConfig
 	lock     sync.RWMutex
 }
 
@@ -65,6 +67,7 @@ func newResettableFreezer(datadir string, namespace string, readonly bool, maxTa
 		freezer:  freezer,
 		opener:   opener,
 		datadir:  datadir,
+		tables:   tables,
 	}, nil
 }
 
@@ -73,12 +76,27 @@ func newResettableFreezer(datadir string, namespace string, readonly bool, maxTa
 // is guaranteed by the rename operation, the leftover directory will be
 // cleaned up in next startup in case crash happens after rename.
 func (f *resettableFreezer) Reset() error {
+	return f.ResetTo(0)
+}
+
+// ResetTo deletes the file directory exclusively occupied by the freezer and
+// recreates an empty freezer whose first item is positioned at the given
+// number, allowing to rebuild the freezer from an item range which doesn't
+// start from zero.
+func (f *resettableFreezer) ResetTo(tail uint64) error {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
 	if f.readOnly {
 		return errReadOnly
 	}
+	if tail != 0 {
+		for name, config := range f.tables {
+			if !config.prunable {
+				return fmt.Errorf("non-prunable freezer table %s can't start at %d", name, tail)
+			}
+		}
+	}
 	if err := f.freezer.Close(); err != nil {
 		return err
 	}
@@ -89,6 +107,13 @@ func (f *resettableFreezer) Reset() error {
 	if err := os.RemoveAll(tmp); err != nil {
 		return err
 	}
+	if tail != 0 {
+		for name, config := range f.tables {
+			if err := initTableTail(f.datadir, name, config, tail); err != nil {
+				return err
+			}
+		}
+	}
 	freezer, err := f.opener()
 	if err != nil {
 		return err
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"sync"
@@ -130,6 +131,29 @@ func newFreezerTable(path, name string, config freezerTableConfig, readonly bool
 	return newTable(path, name, metrics.NewInactiveMeter(), metrics.NewInactiveMeter(), metrics.NewGauge(), freezerTableSize, config, readonly)
 }
 
+// indexFileName returns the name of the index file for the given table.
+func indexFileName(name string, config freezerTableConfig) string {
+	if config.noSnappy {
+		return fmt.Sprintf("%s.ridx", name) // raw index file
+	}
+	return fmt.Sprintf("%s.cidx", name) // compressed index file
+}
+
+// initTableTail creates the index file of an empty freezer table with the
+// first index entry pointing to the given item number, so that the table
+// starts at the tail instead of zero once it's opened. The table must not
+// exist yet.
+func initTableTail(path string, name string, config freezerTableConfig, tail uint64) error {
+	if tail > math.MaxUint32 {
+		return fmt.Errorf("freezer table tail %d out of range", tail)
+	}
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+	entry := indexEntry{filenum: 0, offset: uint32(tail)}
+	return os.WriteFile(filepath.Join(path, indexFileName(name, config)), entry.append(nil), 0644)
+}
+
 // newTable opens a freezer table, creating the data and index files if they are
 // non-existent. Both files are truncated to the shortest common length to ensure
 // they don't go out of sync.
@@ -138,16 +162,11 @@ func newTable(path string, name string, readMeter, writeMeter *metrics.Meter, si
 	if err := os.MkdirAll(path, 0755); err != nil {
 		return nil, err
 	}
-	var idxName string
-	if config.noSnappy {
-		idxName = fmt.Sprintf("%s.ridx", name) // raw index file
-	} else {
-		idxName = fmt.Sprintf("%s.cidx", name) // compressed index file
-	}
 	var (
-		err   error
-		index *os.File
-		meta  *os.File
+		idxName = indexFileName(name, config)
+		err     error
+		index   *os.File
+		meta    *os.File
 	)
 	if readonly {
 		// Will fail if table index file or meta file is not existent
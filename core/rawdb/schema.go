@@ -161,6 +161,10 @@ var (
 	// old log index
 	bloomBitsMetaPrefix = []byte("iB")
 
+	// TraceIndexPrefix is the prefix of the table holding the address index of
+	// the call traces, maintained by the optional trace indexer.
+	TraceIndexPrefix = []byte("trace-index-")
+
 	preimageCounter     = metrics.NewRegisteredCounter("db/preimage/total", nil)
 	preimageHitsCounter = metrics.NewRegisteredCounter("db/preimage/hits", nil)
 	preimageMissCounter = metrics.NewRegisteredCounter("db/preimage/miss", nil)
@@ -18,26 +18,30 @@ package state
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core/state/snapshot"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ethereum/go-ethereum/trie/utils"
 	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/ethereum/go-ethereum/triedb/database"
 	"github.com/ethereum/go-ethereum/triedb/pathdb"
 )
 
 // historicReader wraps a historical state reader defined in path database,
 // providing historic state serving over the path scheme.
 //
-// TODO(rjl493456442): historicReader is not thread-safe and does not fully
-// comply with the StateReader interface requirements, needs to be fixed.
-// Currently, it is only used in a non-concurrent context, so it is safe for now.
+// The wrapped reader caches the index readers internally, the access is
+// serialized to comply with the thread-safety requirement of StateReader.
 type historicReader struct {
 	reader *pathdb.HistoricalStateReader
+	lock   sync.Mutex
 }
 
 // newHistoricReader constructs a reader for historic state serving.
@@ -52,6 +56,9 @@ func newHistoricReader(r *pathdb.HistoricalStateReader) *historicReader {
 //
 // The returned account might be nil if it's not existent.
 func (r *historicReader) Account(addr common.Address) (*types.StateAccount, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	account, err := r.reader.Account(addr)
 	if err != nil {
 		return nil, err
@@ -82,6 +89,9 @@ func (r *historicReader) Account(addr common.Address) (*types.StateAccount, erro
 //
 // The returned storage slot might be empty if it's not existent.
 func (r *historicReader) Storage(addr common.Address, key common.Hash) (common.Hash, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	blob, err := r.reader.Storage(addr, key)
 	if err != nil {
 		return common.Hash{}, err
@@ -128,15 +138,43 @@ func (db *HistoricDB) Reader(stateRoot common.Hash) (Reader, error) {
 	return newReader(newCachingCodeReader(db.disk, db.codeCache, db.codeSizeCache), newHistoricReader(hr)), nil
 }
 
-// OpenTrie opens the main account trie. It's not supported by historic database.
+// historicNodeDB implements database.NodeDatabase, resolving the trie nodes
+// of historical states from the trienode histories.
+type historicNodeDB struct {
+	triedb *triedb.Database
+}
+
+// NodeReader implements database.NodeDatabase, returning a node reader of the
+// specified historical state.
+func (db *historicNodeDB) NodeReader(stateRoot common.Hash) (database.NodeReader, error) {
+	return db.triedb.HistoricNodeReader(stateRoot)
+}
+
+// OpenTrie opens the main account trie. The trie is read-only and is only
+// available if the trienode histories are maintained.
 func (db *HistoricDB) OpenTrie(root common.Hash) (Trie, error) {
-	return nil, errors.New("not implemented")
+	if db.triedb.IsVerkle() {
+		return nil, errors.New("not implemented")
+	}
+	tr, err := trie.NewStateTrie(trie.StateTrieID(root), &historicNodeDB{triedb: db.triedb})
+	if err != nil {
+		return nil, err
+	}
+	return tr, nil
 }
 
-// OpenStorageTrie opens the storage trie of an account. It's not supported by
-// historic database.
-func (db *HistoricDB) OpenStorageTrie(stateRoot common.Hash, address common.Address, root common.Hash, trie Trie) (Trie, error) {
-	return nil, errors.New("not implemented")
+// OpenStorageTrie opens the storage trie of an account. The trie is read-only
+// and is only available if the trienode histories are maintained.
+func (db *HistoricDB) OpenStorageTrie(stateRoot common.Hash, address common.Address, root common.Hash, self Trie) (Trie, error) {
+	if db.triedb.IsVerkle() {
+		return nil, errors.New("not implemented")
+	}
+	id := trie.StorageTrieID(stateRoot, crypto.Keccak256Hash(address.Bytes()), root)
+	tr, err := trie.NewStateTrie(id, &historicNodeDB{triedb: db.triedb})
+	if err != nil {
+		return nil, err
+	}
+	return tr, nil
 }
 
 // PointCache returns the cache holding points used in verkle tree key computation
@@ -0,0 +1,128 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/ethereum/go-ethereum/triedb/pathdb"
+	"github.com/holiman/uint256"
+)
+
+// Tests that the historic database is able to serve the state data and the
+// merkle proofs of the states below the persistent disk layer.
+func TestHistoricDatabaseProof(t *testing.T) {
+	disk, err := rawdb.Open(rawdb.NewMemoryDatabase(), rawdb.OpenOptions{Ancient: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tdb := triedb.NewDatabase(disk, &triedb.Config{PathDB: &pathdb.Config{
+		EnableStateIndexing: true,
+		TrienodeHistory:     true,
+		TrieCleanSize:       256 * 1024,
+		StateCleanSize:      256 * 1024,
+		WriteBufferSize:     256 * 1024,
+		NoAsyncFlush:        true,
+	}})
+	defer tdb.Close()
+
+	var (
+		sdb   = NewDatabase(tdb, nil)
+		addr  = common.HexToAddress("0xdeadbeef")
+		root  = types.EmptyRootHash
+		roots []common.Hash
+	)
+	for i := 0; i < 8; i++ {
+		state, err := New(root, sdb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		state.SetBalance(addr, uint256.NewInt(uint64(i+1)), tracing.BalanceChangeUnspecified)
+		state.SetState(addr, common.Hash{byte(i)}, common.Hash{byte(i + 1)})
+
+		root, err = state.Commit(uint64(i+1), true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Flatten the state into disk for producing the histories
+		if err := tdb.Commit(root, false); err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, root)
+	}
+	for {
+		remain, err := tdb.IndexProgress()
+		if err == nil && remain == 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	hdb := NewHistoricDatabase(disk, tdb)
+
+	// The last state is the disk layer, which is served by the live database
+	for i, root := range roots[:len(roots)-1] {
+		state, err := New(root, hdb)
+		if err != nil {
+			t.Fatalf("Failed to open historic state %d: %v", i, err)
+		}
+		if balance := state.GetBalance(addr); balance.Uint64() != uint64(i+1) {
+			t.Fatalf("Unexpected balance %d, want %d", balance.Uint64(), i+1)
+		}
+		tr, err := hdb.OpenTrie(root)
+		if err != nil {
+			t.Fatalf("Failed to open historic trie %d: %v", i, err)
+		}
+		proof := memorydb.New()
+		if err := tr.Prove(crypto.Keccak256(addr.Bytes()), proof); err != nil {
+			t.Fatalf("Failed to prove account %d: %v", i, err)
+		}
+		blob, err := trie.VerifyProof(root, crypto.Keccak256(addr.Bytes()), proof)
+		if err != nil {
+			t.Fatalf("Invalid account proof %d: %v", i, err)
+		}
+		var account types.StateAccount
+		if err := rlp.DecodeBytes(blob, &account); err != nil {
+			t.Fatal(err)
+		}
+		if account.Balance.Uint64() != uint64(i+1) {
+			t.Fatalf("Unexpected proved balance %d, want %d", account.Balance.Uint64(), i+1)
+		}
+		st, err := hdb.OpenStorageTrie(root, addr, account.Root, tr)
+		if err != nil {
+			t.Fatalf("Failed to open historic storage trie %d: %v", i, err)
+		}
+		for j := 0; j <= i; j++ {
+			enc, err := st.GetStorage(addr, common.Hash{byte(j)}.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := common.BytesToHash(enc); got != (common.Hash{byte(j + 1)}) {
+				t.Fatalf("Unexpected slot %d at state %d: %x", j, i, got)
+			}
+		}
+	}
+}
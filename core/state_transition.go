@@ -328,25 +328,32 @@ func (st *stateTransition) to() common.Address {
 
 // CalculateRollupCostDataFromMessage calculate RollupCostData from message.
 func (st *stateTransition) CalculateRollupCostDataFromMessage() {
+	st.msg.RollupCostData = EstimateRollupCostData(st.msg)
+}
+
+// EstimateRollupCostData approximates the RollupCostData of the transaction the
+// given message will be sent as. It is only meant for gas estimation, the actual
+// L1 cost depends on the transaction the user eventually signs.
+func EstimateRollupCostData(msg *Message) types.RollupCostData {
 	tx := types.NewTx(&types.DynamicFeeTx{
-		Nonce:     st.msg.Nonce,
-		Value:     st.msg.Value,
-		Gas:       st.msg.GasLimit,
-		GasTipCap: st.msg.GasTipCap,
-		GasFeeCap: st.msg.GasFeeCap,
-		Data:      st.msg.Data,
+		Nonce:     msg.Nonce,
+		Value:     msg.Value,
+		Gas:       msg.GasLimit,
+		GasTipCap: msg.GasTipCap,
+		GasFeeCap: msg.GasFeeCap,
+		Data:      msg.Data,
 	})
-
-	st.msg.RollupCostData = tx.RollupCostData()
+	data := tx.RollupCostData()
 
 	// add a constant to cover sigs(V,R,S) and other data to make sure that the gasLimit from eth_estimateGas can cover L1 cost
 	// just used for estimateGas and the actual L1 cost depends on users' tx when executing
-	st.msg.RollupCostData.Ones += 80
+	data.Ones += 80
 
 	// add a constant to cover meta tx sigs(V,R,S)
-	if st.msg.MetaTxParams != nil {
-		st.msg.RollupCostData.Ones += 80
+	if msg.MetaTxParams != nil {
+		data.Ones += 80
 	}
+	return data
 }
 
 func (st *stateTransition) buyGas(metaTxV3 bool) (*big.Int, error) {
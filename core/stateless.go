@@ -35,6 +35,12 @@ import (
 // everything it can locally and returns the state root and receipt root, that
 // need the other side to explicitly check.
 //
+// Mantle blocks need no special handling, neither here nor in the witness
+// generation: the rollup specific state accesses, i.e. the deposits minting MNT
+// and BVM_ETH, the L1 cost oracle reads and the token ratio scaling the gas, all
+// go through the state database and are recorded in the witness like any other
+// access.
+//
 // This method is a bit of a sore thumb here, but:
 //   - It cannot be placed in core/stateless, because state.New prodces a circular dep
 //   - It cannot be placed outside of core, because it needs to construct a dud headerchain
@@ -0,0 +1,134 @@
+package core
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+// TestExecuteStatelessMantle checks that the witnesses of Mantle blocks cover
+// the rollup specific state accesses: deposits minting MNT and BVM_ETH, the L1
+// cost oracle reads and the tokenRatio scaling of the gas. The witness generation
+// records these without any Mantle specific code, as they are all made through
+// the state database, so the test checks the witness of a block for the slots
+// of the oracles and BVM_ETH besides cross-checking every block against it.
+func TestExecuteStatelessMantle(t *testing.T) {
+	var (
+		key, _    = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		sender    = crypto.PubkeyToAddress(key.PublicKey)
+		recipient = common.Address{0xaa}
+		gspec     = DeveloperRollupGenesisBlock(30_000_000, &sender)
+		engine    = beacon.New(ethash.NewFaker())
+		signer    = types.LatestSigner(gspec.Config)
+		selector  = crypto.Keccak256([]byte("setTokenRatio(uint256)"))[:4]
+	)
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, 3, func(i int, b *BlockGen) {
+		b.SetParentBeaconRoot(common.Hash{byte(i + 1)})
+
+		// Update the token ratio through the gas price oracle
+		b.AddTx(types.NewTx(&types.DepositTx{
+			SourceHash: common.Hash{byte(i), 1},
+			From:       types.L1InfoDepositerAddress,
+			To:         &types.GasOracleAddr,
+			Value:      new(big.Int),
+			Gas:        100_000,
+			Data:       slices.Concat(selector, common.BigToHash(big.NewInt(int64(i+2))).Bytes()),
+		}))
+		// Deposit minting MNT and BVM_ETH to the recipient
+		b.AddTx(types.NewTx(&types.DepositTx{
+			SourceHash: common.Hash{byte(i), 2},
+			From:       recipient,
+			To:         &recipient,
+			Mint:       big.NewInt(params.Ether),
+			Value:      big.NewInt(params.GWei),
+			Gas:        100_000,
+			EthValue:   big.NewInt(params.GWei),
+			EthTxValue: big.NewInt(params.GWei),
+		}))
+		// Regular transaction paying the L1 fee and the scaled gas
+		b.AddTx(types.MustSignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   gspec.Config.ChainID,
+			Nonce:     uint64(i),
+			To:        &recipient,
+			Value:     big.NewInt(1),
+			Gas:       1_000_000,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: new(big.Int).Mul(b.BaseFee(), big.NewInt(2)),
+			Data:      []byte{0x01, 0x02, 0x03},
+		}))
+	})
+	// Import the chain with stateless self-validation enabled, cross-checking
+	// every block against its witness.
+	cfg := DefaultConfig()
+	cfg.VmConfig.StatelessSelfValidation = true
+	chain, err := NewBlockChain(rawdb.NewMemoryDatabase(), gspec, engine, cfg)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if n, err := chain.InsertChain(blocks[:2]); err != nil {
+		t.Fatalf("block %d: failed to import: %v", n, err)
+	}
+	witness, err := chain.InsertBlockWithoutSetHead(blocks[2], true)
+	if err != nil {
+		t.Fatalf("failed to import block 2: %v", err)
+	}
+	// The witness must prove the oracle values the L1 fee is priced with, as
+	// well as the BVM_ETH balance and supply updated by the mint.
+	parent, err := chain.StateAt(blocks[1].Root())
+	if err != nil {
+		t.Fatalf("failed to open parent state: %v", err)
+	}
+	proven, err := state.New(witness.Root(), state.NewDatabase(triedb.NewDatabase(witness.MakeHashDB(), triedb.HashDefaults), nil))
+	if err != nil {
+		t.Fatalf("failed to open witness state: %v", err)
+	}
+	for _, slot := range []struct {
+		addr common.Address
+		key  common.Hash
+	}{
+		{types.L1BlockAddr, types.L1BaseFeeSlot},
+		{types.L1BlockAddr, types.OverheadSlot},
+		{types.L1BlockAddr, types.ScalarSlot},
+		{types.GasOracleAddr, types.TokenRatioSlot},
+		{BVM_ETH_ADDR, getBVMETHBalanceKey(recipient)},
+		{BVM_ETH_ADDR, getBVMETHTotalSupplyKey()},
+	} {
+		want := parent.GetState(slot.addr, slot.key)
+		if want == (common.Hash{}) {
+			t.Fatalf("slot %x of %x is empty in the parent state", slot.key, slot.addr)
+		}
+		if have := proven.GetState(slot.addr, slot.key); have != want {
+			t.Errorf("slot %x of %x not proven by the witness: have %x, want %x", slot.key, slot.addr, have, want)
+		}
+	}
+	if err := proven.Error(); err != nil {
+		t.Fatalf("witness is incomplete: %v", err)
+	}
+	receipts := chain.GetReceiptsByHash(blocks[2].Hash())
+	if len(receipts) != 3 {
+		t.Fatalf("have %d receipts, want 3", len(receipts))
+	}
+	for i, receipt := range receipts {
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("transaction %d failed", i)
+		}
+	}
+	if ratio := receipts[2].TokenRatio; ratio == nil || ratio.Uint64() != 4 {
+		t.Fatalf("have token ratio %v, want 4", ratio)
+	}
+	if receipts[2].L1Fee == nil || receipts[2].L1Fee.Sign() == 0 {
+		t.Fatal("missing L1 fee")
+	}
+}
@@ -265,7 +265,8 @@ type LegacyPool struct {
 
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
 
-	l1CostFn txpool.L1CostFunc // To apply L1 costs as rollup, optional field, may be nil.
+	l1CostFn      txpool.L1CostFunc         // To apply L1 costs as rollup, optional field, may be nil.
+	l1FeeParamsFn func() *types.L1FeeParams // Predicts the fee oracle values of the next block, optional field, may be nil.
 
 	// Preconf variables
 	preconfReadyCh       chan struct{}
@@ -453,6 +454,17 @@ func (pool *LegacyPool) SetGasTip(tip *big.Int) {
 	log.Info("Legacy pool tip threshold updated", "tip", newTip)
 }
 
+// SetL1FeeParamsFunc sets the function predicting the fee oracle values of the
+// next block. New transactions are validated against the predicted token ratio
+// and L1 cost, falling back to the values of the current head if the function
+// has no prediction.
+func (pool *LegacyPool) SetL1FeeParamsFunc(fn func() *types.L1FeeParams) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.l1FeeParamsFn = fn
+}
+
 // Nonce returns the next nonce of an account, with all transactions executable
 // by the pool already applied on top.
 func (pool *LegacyPool) Nonce(addr common.Address) uint64 {
@@ -619,7 +631,17 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 		},
 		L1CostFn: pool.l1CostFn,
 	}
-	if err := txpool.ValidateTransactionWithState(tx, pool.currentHead.Load(), pool.signer, opts); err != nil {
+	head := pool.currentHead.Load()
+	if pool.l1FeeParamsFn != nil {
+		if fees := pool.l1FeeParamsFn(); fees != nil {
+			costFn := fees.L1CostFunc(pool.chainconfig)
+			opts.L1FeeParams = fees
+			opts.L1CostFn = func(rollupCostData types.RollupCostData, isDepositTx bool, to *common.Address) *big.Int {
+				return costFn(head.Number.Uint64()+1, head.Time, rollupCostData, isDepositTx, to)
+			}
+		}
+	}
+	if err := txpool.ValidateTransactionWithState(tx, head, pool.signer, opts); err != nil {
 		return err
 	}
 	return pool.validateAuth(tx)
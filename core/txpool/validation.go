@@ -252,6 +252,10 @@ type ValidationOptionsWithState struct {
 
 	// L1CostFn is an optional extension, to validate L1 rollup costs of a tx
 	L1CostFn L1CostFunc
+
+	// L1FeeParams are the optional fee oracle values predicted for the next
+	// block. If set, the token ratio is taken from these instead of State.
+	L1FeeParams *types.L1FeeParams
 }
 
 // ValidateTransactionWithState is a helper method to check whether a transaction
@@ -285,6 +289,9 @@ func ValidateTransactionWithState(tx *types.Transaction, head *types.Header, sig
 		rules      = opts.Config.Rules(head.Number, head.Difficulty.Sign() == 0, head.Time)
 		tokenRatio = opts.State.GetState(types.GasOracleAddr, types.TokenRatioSlot).Big().Uint64()
 	)
+	if opts.L1FeeParams != nil {
+		tokenRatio = opts.L1FeeParams.TokenRatio.Uint64()
+	}
 	if balance.Cmp(cost) < 0 {
 		return fmt.Errorf("%w: balance %v, tx cost %v, overshot %v", core.ErrInsufficientFunds, balance, cost, new(big.Int).Sub(cost, balance))
 	}
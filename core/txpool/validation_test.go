@@ -25,9 +25,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 )
 
 func TestValidateTransactionEIP2681(t *testing.T) {
@@ -96,6 +99,46 @@ func TestValidateTransactionEIP2681(t *testing.T) {
 	}
 }
 
+// Tests that the intrinsic gas of a transaction is scaled by the predicted token
+// ratio of the next block, if there is one.
+func TestValidateTransactionPredictedTokenRatio(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	statedb.SetBalance(crypto.PubkeyToAddress(key.PublicKey), uint256.NewInt(params.Ether), tracing.BalanceChangeUnspecified)
+	statedb.SetState(types.GasOracleAddr, types.TokenRatioSlot, common.BigToHash(common.Big1))
+
+	var (
+		head = &types.Header{
+			Number:     big.NewInt(1),
+			GasLimit:   5000000,
+			Time:       1,
+			Difficulty: big.NewInt(1),
+			BaseFee:    big.NewInt(0),
+		}
+		signer = types.HomesteadSigner{}
+		tx     = createTestTransaction(key, 0)
+	)
+	newOpts := func(fees *types.L1FeeParams) *ValidationOptionsWithState {
+		return &ValidationOptionsWithState{
+			State:               statedb,
+			Config:              params.TestChainConfig,
+			ExistingExpenditure: func(addr common.Address) *big.Int { return new(big.Int) },
+			ExistingCost:        func(addr common.Address, nonce uint64) *big.Int { return nil },
+			L1FeeParams:         fees,
+		}
+	}
+	if err := ValidateTransactionWithState(tx, head, signer, newOpts(nil)); err != nil {
+		t.Fatalf("failed to validate against the current token ratio: %v", err)
+	}
+	predicted := &types.L1FeeParams{TokenRatio: big.NewInt(2)}
+	if err := ValidateTransactionWithState(tx, head, signer, newOpts(predicted)); !errors.Is(err, core.ErrIntrinsicGas) {
+		t.Fatalf("have error %v, want %v", err, core.ErrIntrinsicGas)
+	}
+}
+
 // createTestTransaction creates a basic transaction for testing
 func createTestTransaction(key *ecdsa.PrivateKey, nonce uint64) *types.Transaction {
 	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
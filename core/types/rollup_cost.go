@@ -65,6 +65,10 @@ var (
 	L1BlockAddr   = common.HexToAddress("0x4200000000000000000000000000000000000015")
 	GasOracleAddr = common.HexToAddress("0x420000000000000000000000000000000000000F")
 	Decimals      = big.NewInt(1_000_000)
+
+	// L1InfoDepositerAddress is the sender of the L1 attributes deposit that
+	// starts every L2 block.
+	L1InfoDepositerAddress = common.HexToAddress("0xdeaddeaddeaddeaddeaddeaddeaddeaddead0001")
 )
 
 // NewL1CostFunc returns a function used for calculating L1 fee cost.
@@ -104,6 +108,38 @@ func L1Cost(rollupDataGas uint64, l1BaseFee, overhead, scalar, tokenRatio *big.I
 	return l1Cost.Div(l1Cost, Decimals)
 }
 
+// L1FeeParams are the fee oracle values the L1 cost of a transaction is
+// computed from.
+type L1FeeParams struct {
+	L1BaseFee  *big.Int
+	Overhead   *big.Int
+	Scalar     *big.Int
+	TokenRatio *big.Int
+}
+
+// ReadL1FeeParams reads the fee oracle values from the given state.
+func ReadL1FeeParams(state StateGetter) *L1FeeParams {
+	l1BaseFee, overhead, scalar, _ := readL1BlockStorageSlots(L1BlockAddr, state)
+	return &L1FeeParams{
+		L1BaseFee:  l1BaseFee,
+		Overhead:   overhead,
+		Scalar:     scalar,
+		TokenRatio: readGPOStorageSlots(GasOracleAddr, state),
+	}
+}
+
+// L1CostFunc returns a function calculating the L1 fee cost with the fee oracle
+// values p, regardless of the block the message is executed in.
+func (p *L1FeeParams) L1CostFunc(config *params.ChainConfig) L1CostFunc {
+	return func(blockNum uint64, blockTime uint64, rollupCostData RollupCostData, isDepositTx bool, to *common.Address) *big.Int {
+		rollupDataGas := rollupCostData.DataGas(blockTime, config)
+		if config.Optimism == nil || isDepositTx || rollupDataGas == 0 {
+			return common.Big0
+		}
+		return L1Cost(rollupDataGas, p.L1BaseFee, p.Overhead, p.Scalar, p.TokenRatio)
+	}
+}
+
 // DeriveL1GasInfo reads L1 gas related information to be included
 // on the receipt
 func DeriveL1GasInfo(state StateGetter) (*big.Int, *big.Int, *big.Int, *big.Float, *big.Int) {
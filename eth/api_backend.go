@@ -26,7 +26,6 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
@@ -223,6 +222,10 @@ func (b *EthAPIBackend) Pending() (*types.Block, types.Receipts, *state.StateDB)
 	return b.eth.miner.Pending()
 }
 
+func (b *EthAPIBackend) PendingL1FeeParams() *types.L1FeeParams {
+	return b.eth.miner.PendingL1FeeParams()
+}
+
 func (b *EthAPIBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*state.StateDB, *types.Header, error) {
 	// Pending state is only known by the miner
 	if number == rpc.PendingBlockNumber {
@@ -240,14 +243,7 @@ func (b *EthAPIBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.B
 	if header == nil {
 		return nil, nil, errors.New("header not found")
 	}
-	stateDb, err := b.eth.BlockChain().StateAt(header.Root)
-	if err != nil {
-		stateDb, err = b.eth.BlockChain().HistoricState(header.Root)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-	return stateDb, header, nil
+	return b.stateAndHeader(header)
 }
 
 func (b *EthAPIBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
@@ -265,16 +261,26 @@ func (b *EthAPIBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockN
 		if blockNrOrHash.RequireCanonical && b.eth.blockchain.GetCanonicalHash(header.Number.Uint64()) != hash {
 			return nil, nil, errors.New("hash is not currently canonical")
 		}
-		stateDb, err := b.eth.BlockChain().StateAt(header.Root)
+		return b.stateAndHeader(header)
+	}
+	return nil, nil, errors.New("invalid arguments; neither block nor hash specified")
+}
+
+// stateAndHeader returns the state of the given block. The state of pre-Bedrock
+// blocks only exists on the historical node, retrieving it fails with
+// rpc.ErrNoHistoricalFallback so that the RPC server forwards the call there.
+func (b *EthAPIBackend) stateAndHeader(header *types.Header) (*state.StateDB, *types.Header, error) {
+	if b.ChainConfig().IsOptimismPreBedrock(header.Number) {
+		return nil, nil, rpc.ErrNoHistoricalFallback
+	}
+	stateDb, err := b.eth.BlockChain().StateAt(header.Root)
+	if err != nil {
+		stateDb, err = b.eth.BlockChain().HistoricState(header.Root)
 		if err != nil {
-			stateDb, err = b.eth.BlockChain().HistoricState(header.Root)
-			if err != nil {
-				return nil, nil, err
-			}
+			return nil, nil, err
 		}
-		return stateDb, header, nil
 	}
-	return nil, nil, errors.New("invalid arguments; neither block nor hash specified")
+	return stateDb, header, nil
 }
 
 func (b *EthAPIBackend) HistoryPruningCutoff() uint64 {
@@ -328,12 +334,12 @@ func (b *EthAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction)
 		return types.ErrTxTypeNotSupported
 	}
 
-	if b.eth.seqRPCService != nil {
+	if b.eth.sequencer != nil {
 		data, err := signedTx.MarshalBinary()
 		if err != nil {
 			return err
 		}
-		if err := b.eth.seqRPCService.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(data)); err != nil {
+		if err := b.eth.sequencer.sendRawTransaction(ctx, signedTx.Hash(), data); err != nil {
 			return fmt.Errorf("failed to forward tx to sequencer, err: '%w'", err)
 		}
 	}
@@ -343,7 +349,7 @@ func (b *EthAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction)
 
 	// Retain tx in local tx pool after forwarding, for local RPC usage.
 	err := b.sendTx(ctx, signedTx)
-	if err != nil && b.eth.seqRPCService != nil {
+	if err != nil && b.eth.sequencer != nil {
 		log.Warn("successfully sent tx to sequencer, but failed to persist in local tx pool", "err", err, "tx", signedTx.Hash())
 		return nil
 	}
@@ -373,13 +379,13 @@ func (b *EthAPIBackend) sendTx(ctx context.Context, signedTx *types.Transaction)
 }
 
 func (b *EthAPIBackend) SendTxWithPreconf(ctx context.Context, tx *types.Transaction) (*core.NewPreconfTxEvent, error) {
-	if b.eth.seqRPCService != nil {
+	if b.eth.sequencer != nil {
 		data, err := tx.MarshalBinary()
 		if err != nil {
 			return nil, err
 		}
-		var result *core.NewPreconfTxEvent
-		if err = b.eth.seqRPCService.CallContext(ctx, &result, "eth_sendRawTransactionWithPreconf", hexutil.Encode(data)); err != nil {
+		result, err := b.eth.sequencer.sendRawTransactionWithPreconf(ctx, tx.Hash(), data)
+		if err != nil {
 			return nil, fmt.Errorf("failed to forward tx to sequencer, please try again. Error message: '%w'", err)
 		}
 		return result, nil
@@ -551,6 +557,18 @@ func (b *EthAPIBackend) RPCTxFeeCap() float64 {
 	return b.eth.config.RPCTxFeeCap
 }
 
+func (b *EthAPIBackend) RPCBlockRangeLimit() uint64 {
+	return b.eth.config.RPCBlockRangeLimit
+}
+
+func (b *EthAPIBackend) RPCBlockRangeConcurrency() int {
+	return b.eth.config.RPCBlockRangeConcurrency
+}
+
+func (b *EthAPIBackend) RPCTokenRatioMargin() float64 {
+	return b.eth.config.RPCTokenRatioMargin
+}
+
 func (b *EthAPIBackend) CurrentView() *filtermaps.ChainView {
 	head := b.eth.blockchain.CurrentBlock()
 	if head == nil {
@@ -579,10 +597,6 @@ func (b *EthAPIBackend) StateAtTransaction(ctx context.Context, block *types.Blo
 	return b.eth.stateAtTransaction(ctx, block, txIndex, reexec)
 }
 
-func (b *EthAPIBackend) HistoricalRPCService() *rpc.Client {
-	return b.eth.historicalRPCService
-}
-
 func (b *EthAPIBackend) Genesis() *types.Block {
 	return b.eth.blockchain.Genesis()
 }
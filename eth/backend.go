@@ -101,8 +101,8 @@ type Ethereum struct {
 	discmix *enode.FairMix
 	dropper *dropper
 
-	seqRPCService        *rpc.Client
-	historicalRPCService *rpc.Client
+	sequencer            *sequencerClient
+	historicalRPCService *ethapi.HistoricalRPC
 
 	// DB interfaces
 	chainDb ethdb.Database // Block chain database
@@ -124,6 +124,8 @@ type Ethereum struct {
 
 	p2pServer *p2p.Server
 
+	responseCache *rpc.ResponseCache // Cache of the immutable RPC responses, nil if disabled
+
 	lock sync.RWMutex // Protects the variadic fields (e.g. gas price and etherbase)
 
 	shutdownTracker *shutdowncheck.ShutdownTracker // Tracks if and when the node has shutdown ungracefully
@@ -145,6 +147,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		log.Warn("Sanitizing invalid miner gas price", "provided", config.Miner.GasPrice, "updated", ethconfig.Defaults.Miner.GasPrice)
 		config.Miner.GasPrice = new(big.Int).Set(ethconfig.Defaults.Miner.GasPrice)
 	}
+	if config.Miner.PreconfConfig == nil {
+		config.Miner.PreconfConfig = ethconfig.Defaults.Miner.PreconfConfig
+	}
 	if config.NoPruning && config.TrieDirtyCache > 0 && config.StateScheme == rawdb.HashScheme {
 		if config.SnapshotCache > 0 {
 			config.TrieCleanCache += config.TrieDirtyCache * 3 / 5
@@ -204,6 +209,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		networkID:       networkID,
 		gasPrice:        config.Miner.GasPrice,
 		p2pServer:       stack.Server(),
+		responseCache:   stack.RPCResponseCache(),
 		discmix:         enode.NewFairMix(discmixTimeout),
 		shutdownTracker: shutdowncheck.NewShutdownTracker(chainDb),
 	}
@@ -234,6 +240,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			SnapshotLimit:    config.SnapshotCache,
 			Preimages:        config.Preimages,
 			StateHistory:     config.StateHistory,
+			TrienodeHistory:  config.TrienodeHistory,
 			StateScheme:      scheme,
 			ChainHistoryMode: config.HistoryMode,
 			TxLookupLimit:    int64(min(config.TransactionHistory, math.MaxInt64)),
@@ -250,6 +257,10 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			StateSizeTracking:    config.EnableStateSizeTracking,
 		}
 	)
+	if eth.responseCache != nil {
+		// The rewinds may drop finalized blocks, the cached responses are stale.
+		options.OnRewind = eth.responseCache.Purge
+	}
 	if config.VMTrace != "" {
 		traceConfig := json.RawMessage("{}")
 		if config.VMTraceJsonConfig != "" {
@@ -285,6 +296,16 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		overrides.OverrideOptimism = config.OverrideOptimism
 	}
 	overrides.ApplyMantleUpgrades = config.ApplyMantleUpgrades
+	overrides.MantleUpgradeConfig = config.MantleUpgradeConfig
+	if config.OverrideMantleEverest != nil {
+		overrides.OverrideMantleEverest = config.OverrideMantleEverest
+	}
+	if config.OverrideMantleSkadi != nil {
+		overrides.OverrideMantleSkadi = config.OverrideMantleSkadi
+	}
+	if config.OverrideMantleLimb != nil {
+		overrides.OverrideMantleLimb = config.OverrideMantleLimb
+	}
 	options.Overrides = &overrides
 
 	eth.blockchain, err = core.NewBlockChain(chainDb, config.Genesis, eth.engine, options)
@@ -377,6 +398,10 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	eth.miner = miner.New(eth, config.Miner, eth.engine)
 	eth.miner.SetExtra(makeExtraData(config.Miner.ExtraData))
 	eth.miner.SetPrioAddresses(config.TxPool.Locals)
+	if config.Miner.PreconfConfig.EnablePreconfChecker {
+		// Validate new transactions against the fee oracle values of the next block
+		legacyPool.SetL1FeeParamsFunc(eth.miner.PendingL1FeeParams)
+	}
 
 	eth.APIBackend = &EthAPIBackend{stack.Config().ExtRPCEnabled(), stack.Config().AllowUnprotectedTxs, config.RollupDisableTxPoolAdmission, eth, nil}
 	if eth.APIBackend.allowUnprotectedTxs {
@@ -385,13 +410,11 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	eth.APIBackend.gpo = gasprice.NewOracle(eth.APIBackend, config.GPO, config.Miner.GasPrice)
 
 	if config.RollupSequencerHTTP != "" {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		client, err := rpc.DialContext(ctx, config.RollupSequencerHTTP)
-		cancel()
+		sequencer, err := newSequencerClient(config.RollupSequencerHTTP, config.RollupSequencerRetries, config.RollupSequencerHealthCheck)
 		if err != nil {
 			return nil, err
 		}
-		eth.seqRPCService = client
+		eth.sequencer = sequencer
 	}
 
 	if config.RollupHistoricalRPC != "" {
@@ -401,7 +424,12 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		if err != nil {
 			return nil, err
 		}
-		eth.historicalRPCService = client
+		eth.historicalRPCService = ethapi.NewHistoricalRPC(client, ethapi.HistoricalRPCConfig{
+			Timeout:        config.RollupHistoricalRPCTimeout,
+			MethodTimeouts: config.RollupHistoricalRPCMethodTimeouts,
+			CacheSize:      config.RollupHistoricalRPCCache,
+		})
+		stack.SetRPCFallback(eth.historicalRPCService.Fallback)
 	}
 
 	// Start the RPC service
@@ -499,6 +527,11 @@ func (s *Ethereum) Start() error {
 	// Regularly update shutdown marker
 	s.shutdownTracker.Start()
 
+	// Start the health checks of the sequencer endpoints
+	if s.sequencer != nil {
+		s.sequencer.start()
+	}
+
 	// Start the networking layer
 	s.handler.Start(s.p2pServer.MaxPeers)
 
@@ -641,8 +674,8 @@ func (s *Ethereum) Stop() error {
 	s.chainDb.Close()
 	s.eventMux.Stop()
 
-	if s.seqRPCService != nil {
-		s.seqRPCService.Close()
+	if s.sequencer != nil {
+		s.sequencer.close()
 	}
 	if s.historicalRPCService != nil {
 		s.historicalRPCService.Close()
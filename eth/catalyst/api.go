@@ -47,10 +47,21 @@ import (
 
 // Register adds the engine API to the full node.
 func Register(stack *node.Node, backend *eth.Ethereum) error {
+	return RegisterWithRecorder(stack, backend, nil)
+}
+
+// RegisterWithRecorder adds the engine API to the full node, appending every
+// engine call and its response to the given recorder, if any.
+func RegisterWithRecorder(stack *node.Node, backend *eth.Ethereum, recorder *Recorder) error {
+	api := NewConsensusAPI(backend)
+	if recorder != nil {
+		api.recorder = recorder
+		stack.RegisterLifecycle(recorder)
+	}
 	stack.RegisterAPIs([]rpc.API{
 		{
 			Namespace:     "engine",
-			Service:       NewConsensusAPI(backend),
+			Service:       api,
 			Authenticated: true,
 		},
 	})
@@ -98,8 +109,9 @@ var (
 type ConsensusAPI struct {
 	eth *eth.Ethereum
 
-	remoteBlocks *headerQueue  // Cache of remote payloads received
-	localBlocks  *payloadQueue // Cache of local payloads generated
+	remoteBlocks   *headerQueue        // Cache of remote payloads received
+	localBlocks    *payloadQueue       // Cache of local payloads generated
+	failedPayloads *failedPayloadQueue // Cache of the reports of local payloads that failed to build
 
 	// The forkchoice update and new payload method require us to return the
 	// latest valid hash in an invalid chain. To support that return, we need
@@ -133,6 +145,8 @@ type ConsensusAPI struct {
 
 	forkchoiceLock sync.Mutex // Lock for the forkChoiceUpdated method
 	newPayloadLock sync.Mutex // Lock for the NewPayload method
+
+	recorder *Recorder // Optional recorder of the engine API calls, for replaying them later
 }
 
 // NewConsensusAPI creates a new consensus api for the given backend.
@@ -155,6 +169,7 @@ func newConsensusAPIWithoutHeartbeat(eth *eth.Ethereum) *ConsensusAPI {
 		eth:               eth,
 		remoteBlocks:      newHeaderQueue(),
 		localBlocks:       newPayloadQueue(),
+		failedPayloads:    newFailedPayloadQueue(),
 		invalidBlocksHits: make(map[common.Hash]int),
 		invalidTipsets:    make(map[common.Hash]*types.Header),
 	}
@@ -226,10 +241,17 @@ func (api *ConsensusAPI) ForkchoiceUpdatedV3(update engine.ForkchoiceStateV1, pa
 	return api.forkchoiceUpdated(update, params, engine.PayloadV3, false)
 }
 
-func (api *ConsensusAPI) forkchoiceUpdated(update engine.ForkchoiceStateV1, payloadAttributes *engine.PayloadAttributes, payloadVersion engine.PayloadVersion, payloadWitness bool) (engine.ForkChoiceResponse, error) {
+func (api *ConsensusAPI) forkchoiceUpdated(update engine.ForkchoiceStateV1, payloadAttributes *engine.PayloadAttributes, payloadVersion engine.PayloadVersion, payloadWitness bool) (resp engine.ForkChoiceResponse, err error) {
 	api.forkchoiceLock.Lock()
 	defer api.forkchoiceLock.Unlock()
 
+	if api.recorder != nil {
+		defer func() {
+			call := &forkchoiceCall{State: update, Attributes: payloadAttributes, Version: payloadVersion}
+			api.recorder.record(recordForkchoiceUpdated, call, resp, err)
+		}()
+	}
+
 	log.Trace("Engine API request received", "method", "ForkchoiceUpdated", "head", update.HeadBlockHash, "finalized", update.FinalizedBlockHash, "safe", update.SafeBlockHash)
 	if update.HeadBlockHash == (common.Hash{}) {
 		log.Warn("Forkchoice requested update to zero hash")
@@ -385,6 +407,16 @@ func (api *ConsensusAPI) forkchoiceUpdated(update engine.ForkchoiceStateV1, payl
 		payload, err := api.eth.Miner().BuildPayload(args, payloadWitness)
 		if err != nil {
 			log.Error("Failed to build payload", "err", err)
+			report := &engine.PayloadDebugV1{
+				PayloadID:  id,
+				ParentHash: update.HeadBlockHash,
+				Timestamp:  hexutil.Uint64(payloadAttributes.Timestamp),
+				Error:      err.Error(),
+			}
+			if forcedErr := new(miner.ForcedTxError); errors.As(err, &forcedErr) {
+				report.ForcedTransactions = forcedErr.Report
+			}
+			api.failedPayloads.put(report)
 			return valid(nil), engine.InvalidPayloadAttributes.With(err)
 		}
 		api.localBlocks.put(id, payload)
@@ -482,11 +514,41 @@ func (api *ConsensusAPI) getPayload(payloadID engine.PayloadID, full bool) (*eng
 	log.Trace("Engine API request received", "method", "GetPayload", "id", payloadID)
 	data := api.localBlocks.get(payloadID, full)
 	if data == nil {
+		if api.recorder != nil {
+			api.recorder.record(recordGetPayload, &getPayloadCall{ID: payloadID, Full: full}, nil, engine.UnknownPayload)
+		}
 		return nil, engine.UnknownPayload
 	}
+	if api.recorder != nil {
+		api.recorder.record(recordGetPayload, &getPayloadCall{ID: payloadID, Full: full}, data, nil)
+	}
 	return data, nil
 }
 
+// GetPayloadDebug returns how the transactions forced into a payload via
+// PayloadAttributes.Transactions were executed: their gas used, status and,
+// for a transaction that failed validation, the reason the payload could not
+// be built. Unlike getPayload, it does not stop the payload building.
+//
+// As a failed forkchoiceUpdated doesn't return a payload id, the report of the
+// latest payload that failed to build is returned if no id is given.
+func (api *ConsensusAPI) GetPayloadDebug(payloadID *engine.PayloadID) (*engine.PayloadDebugV1, error) {
+	log.Trace("Engine API request received", "method", "GetPayloadDebug", "id", payloadID)
+	if payloadID == nil {
+		if report := api.failedPayloads.latest(); report != nil {
+			return report, nil
+		}
+		return nil, engine.UnknownPayload
+	}
+	if report := api.localBlocks.debug(*payloadID); report != nil {
+		return report, nil
+	}
+	if report := api.failedPayloads.get(*payloadID); report != nil {
+		return report, nil
+	}
+	return nil, engine.UnknownPayload
+}
+
 // GetBlobsV1 returns a blob from the transaction pool.
 //
 // Specification:
@@ -684,7 +746,7 @@ func (api *ConsensusAPI) NewPayloadV4(params engine.ExecutableData, versionedHas
 	return api.newPayload(params, versionedHashes, beaconRoot, requests, false)
 }
 
-func (api *ConsensusAPI) newPayload(params engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash, requests [][]byte, witness bool) (engine.PayloadStatusV1, error) {
+func (api *ConsensusAPI) newPayload(params engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash, requests [][]byte, witness bool) (status engine.PayloadStatusV1, err error) {
 	// The locking here is, strictly, not required. Without these locks, this can happen:
 	//
 	// 1. NewPayload( execdata-N ) is invoked from the CL. It goes all the way down to
@@ -709,6 +771,18 @@ func (api *ConsensusAPI) newPayload(params engine.ExecutableData, versionedHashe
 	api.newPayloadLock.Lock()
 	defer api.newPayloadLock.Unlock()
 
+	if api.recorder != nil {
+		defer func() {
+			call := &newPayloadCall{Payload: params, VersionedHashes: versionedHashes, BeaconRoot: beaconRoot}
+			if requests != nil {
+				call.Requests = make([]hexutil.Bytes, len(requests))
+				for i, req := range requests {
+					call.Requests[i] = req
+				}
+			}
+			api.recorder.record(recordNewPayload, call, status, err)
+		}()
+	}
 	log.Trace("Engine API request received", "method", "NewPayload", "number", params.Number, "hash", params.BlockHash)
 	block, err := engine.ExecutableDataToBlock(params, versionedHashes, beaconRoot, requests, api.eth.BlockChain().Config())
 	if err != nil {
@@ -27,6 +27,7 @@ import (
 	"math/big"
 	"math/rand"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/eth"
@@ -224,6 +226,96 @@ func TestEth2PrepareAndGetPayload(t *testing.T) {
 	}
 }
 
+func TestGetPayloadDebug(t *testing.T) {
+	genesis, blocks := generateMergeChain(10, false)
+	n, ethservice := startEthService(t, genesis, blocks)
+	defer n.Close()
+
+	api := newConsensusAPIWithoutHeartbeat(ethservice)
+	parent := ethservice.BlockChain().CurrentBlock()
+
+	// Force a plain transfer and a reverting contract creation into the block,
+	// followed by a transaction with a nonce gap.
+	var (
+		signer = types.LatestSigner(ethservice.BlockChain().Config())
+		revert = []byte{byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT)}
+		gasCap = big.NewInt(2 * params.InitialBaseFee)
+		tx1, _ = types.SignTx(types.NewTransaction(10, common.Address{0x01}, big.NewInt(1), params.TxGas, gasCap, nil), signer, testKey)
+		tx2, _ = types.SignTx(types.NewContractCreation(11, new(big.Int), 100000, gasCap, revert), signer, testKey)
+		tx3, _ = types.SignTx(types.NewTransaction(13, common.Address{0x01}, big.NewInt(1), params.TxGas, gasCap, nil), signer, testKey)
+		encode = func(txs ...*types.Transaction) [][]byte {
+			var enc [][]byte
+			for _, tx := range txs {
+				blob, _ := tx.MarshalBinary()
+				enc = append(enc, blob)
+			}
+			return enc
+		}
+		fcState = engine.ForkchoiceStateV1{HeadBlockHash: parent.Hash()}
+	)
+	attrs := &engine.PayloadAttributes{
+		Timestamp:    parent.Time + 5,
+		Transactions: encode(tx1, tx2),
+		NoTxPool:     true,
+	}
+	resp, err := api.ForkchoiceUpdatedV1(fcState, attrs)
+	if err != nil {
+		t.Fatalf("error preparing payload: %v", err)
+	}
+	report, err := api.GetPayloadDebug(resp.PayloadID)
+	if err != nil {
+		t.Fatalf("error getting payload report: %v", err)
+	}
+	if report.Number == nil || uint64(*report.Number) != parent.Number.Uint64()+1 || report.ParentHash != parent.Hash() {
+		t.Fatalf("unexpected payload report: %+v", report)
+	}
+	if len(report.ForcedTransactions) != 2 {
+		t.Fatalf("have %d forced transaction results, want 2", len(report.ForcedTransactions))
+	}
+	if have := report.ForcedTransactions[0]; have.Status != engine.ForcedTxSuccess || uint64(have.GasUsed) != params.TxGas || have.From != testAddr {
+		t.Fatalf("unexpected result of transfer: %+v", have)
+	}
+	if have := report.ForcedTransactions[1]; have.Status != engine.ForcedTxReverted || have.GasUsed == 0 || have.Hash != tx2.Hash() {
+		t.Fatalf("unexpected result of reverting creation: %+v", have)
+	}
+	// A transaction failing validation aborts the build, the report must be
+	// available nonetheless.
+	attrs = &engine.PayloadAttributes{
+		Timestamp:    parent.Time + 6,
+		Transactions: encode(tx1, tx3, tx2),
+		NoTxPool:     true,
+	}
+	if _, err := api.ForkchoiceUpdatedV1(fcState, attrs); err == nil {
+		t.Fatal("expected payload build to fail")
+	}
+	report, err = api.GetPayloadDebug(nil)
+	if err != nil {
+		t.Fatalf("error getting failed payload report: %v", err)
+	}
+	if report.Error == "" || report.BlockHash != nil || uint64(report.Timestamp) != parent.Time+6 {
+		t.Fatalf("unexpected failed payload report: %+v", report)
+	}
+	want := []string{engine.ForcedTxSuccess, engine.ForcedTxRejected, engine.ForcedTxSkipped}
+	if len(report.ForcedTransactions) != len(want) {
+		t.Fatalf("have %d forced transaction results, want %d", len(report.ForcedTransactions), len(want))
+	}
+	for i, result := range report.ForcedTransactions {
+		if result.Status != want[i] {
+			t.Errorf("result %d: have status %s, want %s", i, result.Status, want[i])
+		}
+	}
+	if !strings.Contains(report.ForcedTransactions[1].Error, "nonce too high") {
+		t.Errorf("unexpected rejection reason: %s", report.ForcedTransactions[1].Error)
+	}
+	// The failed build must still be retrievable by its id.
+	if _, err := api.GetPayloadDebug(&report.PayloadID); err != nil {
+		t.Fatalf("error getting failed payload report by id: %v", err)
+	}
+	if _, err := api.GetPayloadDebug(&engine.PayloadID{0xff}); err == nil {
+		t.Fatal("expected error for unknown payload")
+	}
+}
+
 func checkLogEvents(t *testing.T, logsCh <-chan []*types.Log, rmLogsCh <-chan core.RemovedLogsEvent, wantNew, wantRemoved int) {
 	t.Helper()
 
@@ -91,6 +91,23 @@ func (q *payloadQueue) get(id engine.PayloadID, full bool) *engine.ExecutionPayl
 	return nil
 }
 
+// debug retrieves the forced transaction report of a previously stored payload
+// or nil if it does not exist.
+func (q *payloadQueue) debug(id engine.PayloadID) *engine.PayloadDebugV1 {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	for _, item := range q.payloads {
+		if item == nil {
+			return nil // no more items
+		}
+		if item.id == id {
+			return item.payload.Debug()
+		}
+	}
+	return nil
+}
+
 // has checks if a particular payload is already tracked.
 func (q *payloadQueue) has(id engine.PayloadID) bool {
 	q.lock.RLock()
@@ -107,6 +124,55 @@ func (q *payloadQueue) has(id engine.PayloadID) bool {
 	return false
 }
 
+// failedPayloadQueue tracks the reports of the latest handful of payloads that
+// failed to build, to be retrieved by operators diagnosing derivation issues.
+type failedPayloadQueue struct {
+	reports []*engine.PayloadDebugV1
+	lock    sync.RWMutex
+}
+
+// newFailedPayloadQueue creates a pre-initialized queue with a fixed number of
+// slots all containing empty items.
+func newFailedPayloadQueue() *failedPayloadQueue {
+	return &failedPayloadQueue{
+		reports: make([]*engine.PayloadDebugV1, maxTrackedPayloads),
+	}
+}
+
+// put inserts a new failure report into the queue.
+func (q *failedPayloadQueue) put(report *engine.PayloadDebugV1) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	copy(q.reports[1:], q.reports)
+	q.reports[0] = report
+}
+
+// get retrieves the latest failure report of the given payload or nil if it
+// does not exist.
+func (q *failedPayloadQueue) get(id engine.PayloadID) *engine.PayloadDebugV1 {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	for _, report := range q.reports {
+		if report == nil {
+			return nil // no more items
+		}
+		if report.PayloadID == id {
+			return report
+		}
+	}
+	return nil
+}
+
+// latest retrieves the most recent failure report or nil if there is none.
+func (q *failedPayloadQueue) latest() *engine.PayloadDebugV1 {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	return q.reports[0]
+}
+
 // headerQueueItem represents an hash->header tuple to store until it's retrieved
 // or evicted.
 type headerQueueItem struct {
@@ -0,0 +1,209 @@
+package catalyst
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Engine API calls captured by the recorder.
+const (
+	recordForkchoiceUpdated = "forkchoiceUpdated"
+	recordGetPayload        = "getPayload"
+	recordNewPayload        = "newPayload"
+)
+
+// recordingFile is the name of the active recording file within the recording
+// directory. Rotated files are named after it with a timestamp suffix.
+const recordingFile = "engine.jsonl"
+
+// EngineRecord is a single engine API call along with its response, as
+// written by the Recorder.
+type EngineRecord struct {
+	Seq    uint64          `json:"seq"`
+	Time   time.Time       `json:"time"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// forkchoiceCall is the recorded request of a forkchoiceUpdated call.
+type forkchoiceCall struct {
+	State      engine.ForkchoiceStateV1  `json:"state"`
+	Attributes *engine.PayloadAttributes `json:"attributes,omitempty"`
+	Version    engine.PayloadVersion     `json:"version"`
+}
+
+// getPayloadCall is the recorded request of a getPayload call.
+type getPayloadCall struct {
+	ID   engine.PayloadID `json:"id"`
+	Full bool             `json:"full"`
+}
+
+// newPayloadCall is the recorded request of a newPayload call.
+type newPayloadCall struct {
+	Payload         engine.ExecutableData `json:"payload"`
+	VersionedHashes []common.Hash         `json:"versionedHashes"`
+	BeaconRoot      *common.Hash          `json:"beaconRoot,omitempty"`
+	Requests        []hexutil.Bytes       `json:"requests"`
+}
+
+// RecorderConfig configures the engine API recorder.
+type RecorderConfig struct {
+	Path       string // Directory to store the recording files in
+	MaxSize    int    // Maximum size in megabytes of a recording file before it gets rotated, defaults to 100
+	MaxBackups int    // Maximum number of rotated files to retain, zero retains all of them
+}
+
+// Recorder appends every engine API call and its response to a rotating JSON
+// lines file, so that the exact sequence of calls made by the consensus client
+// can be replayed later on with Replay.
+type Recorder struct {
+	out  *lumberjack.Logger
+	seq  uint64
+	lock sync.Mutex
+}
+
+// NewRecorder creates an engine API recorder writing into the configured
+// directory.
+func NewRecorder(config RecorderConfig) (*Recorder, error) {
+	if config.Path == "" {
+		return nil, errors.New("engine recording path is required")
+	}
+	if err := os.MkdirAll(config.Path, 0755); err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		out: &lumberjack.Logger{
+			Filename:   filepath.Join(config.Path, recordingFile),
+			MaxSize:    config.MaxSize,
+			MaxBackups: config.MaxBackups,
+		},
+	}, nil
+}
+
+// Start implements node.Lifecycle, starting nothing.
+func (r *Recorder) Start() error {
+	return nil
+}
+
+// Stop implements node.Lifecycle, flushing and closing the recording file.
+func (r *Recorder) Stop() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.out.Close()
+}
+
+// record appends a single engine API call to the recording. Failures are only
+// logged, the recorder must never interfere with the engine API itself.
+func (r *Recorder) record(method string, params any, result any, err error) {
+	rec := EngineRecord{
+		Time:   time.Now(),
+		Method: method,
+	}
+	var encErr error
+	if rec.Params, encErr = json.Marshal(params); encErr != nil {
+		log.Warn("Failed to encode engine API request", "method", method, "err", encErr)
+		return
+	}
+	if result != nil {
+		if rec.Result, encErr = json.Marshal(result); encErr != nil {
+			log.Warn("Failed to encode engine API response", "method", method, "err", encErr)
+			return
+		}
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rec.Seq = r.seq
+	r.seq++
+
+	blob, encErr := json.Marshal(rec)
+	if encErr != nil {
+		log.Warn("Failed to encode engine API record", "method", method, "err", encErr)
+		return
+	}
+	if _, err := r.out.Write(append(blob, '\n')); err != nil {
+		log.Warn("Failed to write engine API record", "method", method, "err", err)
+	}
+}
+
+// ReadRecording loads the engine API calls stored at the given path, which is
+// either a single recording file or a recording directory including rotated
+// files. The calls are returned in the order they were made.
+func ReadRecording(path string) ([]*EngineRecord, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if info.IsDir() {
+		if files, err = filepath.Glob(filepath.Join(path, "engine*.jsonl")); err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no engine recordings found in %s", path)
+		}
+	}
+	var records []*EngineRecord
+	for _, file := range files {
+		recs, err := readRecordingFile(file)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, recs...)
+	}
+	// Sequence numbers restart with the node, so order by time first.
+	slices.SortStableFunc(records, func(a, b *EngineRecord) int {
+		if c := a.Time.Compare(b.Time); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Seq, b.Seq)
+	})
+	return records, nil
+}
+
+func readRecordingFile(file string) ([]*EngineRecord, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		records []*EngineRecord
+		scanner = bufio.NewScanner(f)
+		line    int
+	)
+	scanner.Buffer(nil, 256*1024*1024)
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		rec := new(EngineRecord)
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", file, line, err)
+		}
+		records = append(records, rec)
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,155 @@
+package catalyst
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// recordBlocks drives the engine API through building and importing n blocks
+// with transactions from the pool, recording every call.
+func recordBlocks(t *testing.T, dir string, n int) {
+	genesis, preMergeBlocks := generateMergeChain(10, false)
+	stack, ethservice := startEthService(t, genesis, preMergeBlocks)
+	defer stack.Close()
+
+	recorder, err := NewRecorder(RecorderConfig{Path: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.Stop()
+
+	api := newConsensusAPIWithoutHeartbeat(ethservice)
+	api.recorder = recorder
+
+	signer := types.LatestSigner(ethservice.BlockChain().Config())
+	for i := 0; i < n; i++ {
+		parent := ethservice.BlockChain().CurrentBlock()
+		statedb, _ := ethservice.BlockChain().StateAt(parent.Root)
+		nonce := statedb.GetNonce(testAddr)
+		tx, _ := types.SignTx(types.NewTransaction(nonce, common.Address{0x01}, big.NewInt(1), params.TxGas, big.NewInt(2*params.InitialBaseFee), nil), signer, testKey)
+		if errs := ethservice.TxPool().Add([]*types.Transaction{tx}, true); errs[0] != nil {
+			t.Fatalf("block %d: failed to add tx: %v", i, errs[0])
+		}
+		fcState := engine.ForkchoiceStateV1{HeadBlockHash: parent.Hash()}
+		attrs := &engine.PayloadAttributes{
+			Timestamp:             parent.Time + 1,
+			Random:                crypto.Keccak256Hash([]byte{byte(i)}),
+			SuggestedFeeRecipient: parent.Coinbase,
+		}
+		resp, err := api.ForkchoiceUpdatedV1(fcState, attrs)
+		if err != nil {
+			t.Fatalf("failed to start building payload: %v", err)
+		}
+		payload, err := api.getPayload(*resp.PayloadID, true)
+		if err != nil {
+			t.Fatalf("failed to get payload: %v", err)
+		}
+		if len(payload.ExecutionPayload.Transactions) != 1 {
+			t.Fatalf("payload %d: have %d transactions, want 1", i, len(payload.ExecutionPayload.Transactions))
+		}
+		status, err := api.NewPayloadV1(*payload.ExecutionPayload)
+		if err != nil || status.Status != engine.VALID {
+			t.Fatalf("failed to import payload: %v %v", status.Status, err)
+		}
+		fcState.HeadBlockHash = payload.ExecutionPayload.BlockHash
+		if _, err := api.ForkchoiceUpdatedV1(fcState, nil); err != nil {
+			t.Fatalf("failed to set head: %v", err)
+		}
+		if err := ethservice.TxPool().Sync(); err != nil {
+			t.Fatalf("failed to sync txpool: %v", err)
+		}
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	recordBlocks(t, dir, 3)
+
+	records, err := ReadRecording(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 12 {
+		t.Fatalf("have %d records, want 12", len(records))
+	}
+	for i, rec := range records {
+		if want := []string{recordForkchoiceUpdated, recordGetPayload, recordNewPayload, recordForkchoiceUpdated}[i%4]; rec.Method != want {
+			t.Fatalf("record %d: have method %s, want %s", i, rec.Method, want)
+		}
+	}
+	// Replay the recording against a fresh node, with an empty transaction pool.
+	genesis, preMergeBlocks := generateMergeChain(10, false)
+	stack, ethservice := startEthService(t, genesis, preMergeBlocks)
+	defer stack.Close()
+
+	result, err := Replay(ethservice, records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Divergence != nil {
+		t.Fatalf("unexpected divergence: %+v", result.Divergence)
+	}
+	if result.Calls != 12 || result.Payloads != 6 {
+		t.Fatalf("have %d calls and %d payloads, want 12 and 6", result.Calls, result.Payloads)
+	}
+	if have := ethservice.BlockChain().CurrentBlock().Number.Uint64(); have != 13 {
+		t.Fatalf("have head %d after replay, want 13", have)
+	}
+}
+
+func TestReplayDivergence(t *testing.T) {
+	dir := t.TempDir()
+	recordBlocks(t, dir, 2)
+
+	records, err := ReadRecording(filepath.Join(dir, recordingFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Tamper with the state root of the second built payload, as if the
+	// recorded node had computed a different state.
+	var envelope engine.ExecutionPayloadEnvelope
+	if err := json.Unmarshal(records[5].Result, &envelope); err != nil {
+		t.Fatal(err)
+	}
+	envelope.ExecutionPayload.StateRoot = common.Hash{0xff}
+	if records[5].Result, err = json.Marshal(&envelope); err != nil {
+		t.Fatal(err)
+	}
+	genesis, preMergeBlocks := generateMergeChain(10, false)
+	stack, ethservice := startEthService(t, genesis, preMergeBlocks)
+	defer stack.Close()
+
+	result, err := Replay(ethservice, records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	div := result.Divergence
+	if div == nil {
+		t.Fatal("expected divergence")
+	}
+	if div.Index != 5 || div.Method != recordGetPayload || div.Field != "stateRoot" || div.Number != 12 {
+		t.Fatalf("unexpected divergence: %+v", div)
+	}
+}
+
+func TestReadRecordingMissing(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := ReadRecording(dir); err == nil {
+		t.Fatal("expected error for empty recording directory")
+	}
+	if err := os.WriteFile(filepath.Join(dir, recordingFile), []byte("{\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadRecording(dir); err == nil {
+		t.Fatal("expected error for corrupt recording")
+	}
+}
@@ -0,0 +1,241 @@
+package catalyst
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ReplayDivergence describes the first engine API call whose replayed outcome
+// differs from the recorded one.
+type ReplayDivergence struct {
+	Index    int         `json:"index"`  // Position of the call in the recording
+	Method   string      `json:"method"` // Engine API call that diverged
+	Number   uint64      `json:"number"` // Number of the payload the call refers to
+	Hash     common.Hash `json:"hash"`   // Recorded hash of the payload the call refers to
+	Field    string      `json:"field"`  // Diverging part of the response
+	Recorded string      `json:"recorded"`
+	Replayed string      `json:"replayed"`
+}
+
+// ReplayResult summarizes the replay of an engine API recording.
+type ReplayResult struct {
+	Calls      int               `json:"calls"`    // Number of replayed calls
+	Payloads   int               `json:"payloads"` // Number of built or imported payloads that matched the recording
+	Divergence *ReplayDivergence `json:"divergence,omitempty"`
+}
+
+// Replay feeds the recorded engine API calls into the given backend and stops
+// at the first payload whose block hash, state root, receipts or validity
+// differs from the recording. The backend's database must be in the state the
+// recorded node was in when the recording started, and is modified by the
+// replay.
+//
+// Payloads built from the transaction pool can't be reproduced as such, so the
+// transactions of the built payload, as returned by the recorded getPayload
+// call, are forced into the block instead.
+func Replay(backend *eth.Ethereum, records []*EngineRecord) (*ReplayResult, error) {
+	var (
+		api    = newConsensusAPIWithoutHeartbeat(backend)
+		result = new(ReplayResult)
+		ids    = make(map[engine.PayloadID]engine.PayloadID) // recorded payload id -> replayed payload id
+	)
+	for i, rec := range records {
+		var (
+			div *ReplayDivergence
+			err error
+		)
+		switch rec.Method {
+		case recordForkchoiceUpdated:
+			div, err = replayForkchoiceUpdated(api, records, i, ids)
+		case recordGetPayload:
+			div, err = replayGetPayload(api, rec, i, ids)
+			if err == nil && div == nil && rec.Error == "" {
+				result.Payloads++
+			}
+		case recordNewPayload:
+			div, err = replayNewPayload(api, rec, i)
+			if err == nil && div == nil && rec.Error == "" {
+				result.Payloads++
+			}
+		default:
+			return result, fmt.Errorf("call %d: unknown engine API method %q", i, rec.Method)
+		}
+		if err != nil {
+			return result, fmt.Errorf("call %d (%s): %w", i, rec.Method, err)
+		}
+		result.Calls++
+		if div != nil {
+			result.Divergence = div
+			return result, nil
+		}
+	}
+	return result, nil
+}
+
+func replayForkchoiceUpdated(api *ConsensusAPI, records []*EngineRecord, index int, ids map[engine.PayloadID]engine.PayloadID) (*ReplayDivergence, error) {
+	var (
+		rec  = records[index]
+		call forkchoiceCall
+		want engine.ForkChoiceResponse
+	)
+	if err := json.Unmarshal(rec.Params, &call); err != nil {
+		return nil, err
+	}
+	if len(rec.Result) > 0 {
+		if err := json.Unmarshal(rec.Result, &want); err != nil {
+			return nil, err
+		}
+	}
+	attrs := call.Attributes
+	if attrs != nil && want.PayloadID != nil {
+		if built := findBuiltPayload(records, index, *want.PayloadID); built != nil {
+			cpy := *attrs
+			cpy.Transactions = built.Transactions
+			cpy.NoTxPool = true
+			attrs = &cpy
+		}
+	}
+	have, err := api.forkchoiceUpdated(call.State, attrs, call.Version, false)
+
+	div := &ReplayDivergence{Index: index, Method: rec.Method, Hash: call.State.HeadBlockHash}
+	if header := api.eth.BlockChain().GetHeaderByHash(call.State.HeadBlockHash); header != nil {
+		div.Number = header.Number.Uint64()
+	}
+	if d := compareErrors(div, rec.Error, err); d != nil {
+		return d, nil
+	}
+	if want.PayloadStatus.Status != have.PayloadStatus.Status {
+		div.Field, div.Recorded, div.Replayed = "status", want.PayloadStatus.Status, have.PayloadStatus.Status
+		return div, nil
+	}
+	if want.PayloadID != nil && have.PayloadID != nil {
+		ids[*want.PayloadID] = *have.PayloadID
+	}
+	return nil, nil
+}
+
+// findBuiltPayload returns the payload returned by the first successful
+// getPayload call for the given payload id after the given position.
+func findBuiltPayload(records []*EngineRecord, index int, id engine.PayloadID) *engine.ExecutableData {
+	for _, rec := range records[index+1:] {
+		if rec.Method != recordGetPayload || rec.Error != "" {
+			continue
+		}
+		var call getPayloadCall
+		if err := json.Unmarshal(rec.Params, &call); err != nil || call.ID != id {
+			continue
+		}
+		var envelope engine.ExecutionPayloadEnvelope
+		if err := json.Unmarshal(rec.Result, &envelope); err != nil {
+			log.Warn("Failed to decode recorded payload", "id", id, "err", err)
+			return nil
+		}
+		return envelope.ExecutionPayload
+	}
+	return nil
+}
+
+func replayGetPayload(api *ConsensusAPI, rec *EngineRecord, index int, ids map[engine.PayloadID]engine.PayloadID) (*ReplayDivergence, error) {
+	var call getPayloadCall
+	if err := json.Unmarshal(rec.Params, &call); err != nil {
+		return nil, err
+	}
+	id := call.ID
+	if mapped, ok := ids[id]; ok {
+		id = mapped
+	}
+	// Always wait for the complete payload, it was forced to be the same as the
+	// recorded one.
+	envelope, err := api.getPayload(id, true)
+
+	div := &ReplayDivergence{Index: index, Method: rec.Method}
+	var want engine.ExecutionPayloadEnvelope
+	if rec.Error == "" {
+		if err := json.Unmarshal(rec.Result, &want); err != nil {
+			return nil, err
+		}
+		div.Number, div.Hash = want.ExecutionPayload.Number, want.ExecutionPayload.BlockHash
+	}
+	if d := compareErrors(div, rec.Error, err); d != nil || rec.Error != "" {
+		return d, nil
+	}
+	return comparePayloads(div, want.ExecutionPayload, envelope.ExecutionPayload), nil
+}
+
+func replayNewPayload(api *ConsensusAPI, rec *EngineRecord, index int) (*ReplayDivergence, error) {
+	var (
+		call newPayloadCall
+		want engine.PayloadStatusV1
+	)
+	if err := json.Unmarshal(rec.Params, &call); err != nil {
+		return nil, err
+	}
+	if len(rec.Result) > 0 {
+		if err := json.Unmarshal(rec.Result, &want); err != nil {
+			return nil, err
+		}
+	}
+	var requests [][]byte
+	if call.Requests != nil {
+		requests = convertRequests(call.Requests)
+	}
+	have, err := api.newPayload(call.Payload, call.VersionedHashes, call.BeaconRoot, requests, false)
+
+	div := &ReplayDivergence{Index: index, Method: rec.Method, Number: call.Payload.Number, Hash: call.Payload.BlockHash}
+	if d := compareErrors(div, rec.Error, err); d != nil {
+		return d, nil
+	}
+	if want.Status != have.Status {
+		div.Field, div.Recorded, div.Replayed = "status", want.Status, have.Status
+		if have.ValidationError != nil {
+			div.Replayed += ": " + *have.ValidationError
+		}
+		return div, nil
+	}
+	return nil, nil
+}
+
+// compareErrors fills the divergence if exactly one of the recorded and the
+// replayed calls failed.
+func compareErrors(div *ReplayDivergence, want string, have error) *ReplayDivergence {
+	switch {
+	case want == "" && have == nil:
+		return nil
+	case want != "" && have != nil:
+		return nil
+	case want == "":
+		div.Field, div.Recorded, div.Replayed = "error", "<nil>", have.Error()
+	default:
+		div.Field, div.Recorded, div.Replayed = "error", want, "<nil>"
+	}
+	return div
+}
+
+// comparePayloads fills the divergence with the first field of the payload
+// built during replay that differs from the recorded one.
+func comparePayloads(div *ReplayDivergence, want, have *engine.ExecutableData) *ReplayDivergence {
+	fields := []struct {
+		name       string
+		want, have any
+	}{
+		{"stateRoot", want.StateRoot, have.StateRoot},
+		{"receiptsRoot", want.ReceiptsRoot, have.ReceiptsRoot},
+		{"logsBloom", hexutil.Bytes(want.LogsBloom), hexutil.Bytes(have.LogsBloom)},
+		{"gasUsed", want.GasUsed, have.GasUsed},
+		{"transactions", len(want.Transactions), len(have.Transactions)},
+		{"blockHash", want.BlockHash, have.BlockHash},
+	}
+	for _, f := range fields {
+		if fmt.Sprint(f.want) != fmt.Sprint(f.have) {
+			div.Field, div.Recorded, div.Replayed = f.name, fmt.Sprint(f.want), fmt.Sprint(f.have)
+			return div
+		}
+	}
+	return nil
+}
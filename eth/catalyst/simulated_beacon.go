@@ -89,6 +89,8 @@ type SimulatedBeacon struct {
 	eth         *eth.Ethereum
 	period      uint64
 	withdrawals withdrawalQueue
+	deposits    depositQueue
+	rollup      *rollupSequencer // nil unless running as a simulated rollup
 
 	feeRecipient     common.Address
 	feeRecipientLock sync.Mutex // lock gates concurrent access to the feeRecipient
@@ -140,6 +142,22 @@ func NewSimulatedBeacon(period uint64, feeRecipient common.Address, eth *eth.Eth
 	}, nil
 }
 
+// EnableRollup switches the simulated beacon into rollup mode, where it builds
+// blocks like the Mantle sequencer. It must be called before the beacon is
+// started.
+func (c *SimulatedBeacon) EnableRollup(config *RollupDevConfig) error {
+	if c.eth.BlockChain().Config().Optimism == nil {
+		return errors.New("rollup dev mode requires an optimism chain config")
+	}
+	c.rollup = newRollupSequencer(config)
+	for _, deposit := range config.Deposits {
+		if err := c.deposits.add(deposit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *SimulatedBeacon) setFeeRecipient(feeRecipient common.Address) {
 	c.feeRecipientLock.Lock()
 	c.feeRecipient = feeRecipient
@@ -166,7 +184,7 @@ func (c *SimulatedBeacon) Stop() error {
 
 // sealBlock initiates payload building for a new block and creates a new block
 // with the completed payload.
-func (c *SimulatedBeacon) sealBlock(withdrawals []*types.Withdrawal, timestamp uint64) error {
+func (c *SimulatedBeacon) sealBlock(withdrawals []*types.Withdrawal, timestamp uint64) (err error) {
 	if timestamp <= c.lastBlockTime {
 		timestamp = c.lastBlockTime + 1
 	}
@@ -194,13 +212,32 @@ func (c *SimulatedBeacon) sealBlock(withdrawals []*types.Withdrawal, timestamp u
 
 	var random [32]byte
 	rand.Read(random[:])
-	fcResponse, err := c.engineAPI.forkchoiceUpdated(c.curForkchoiceState, &engine.PayloadAttributes{
+	attributes := &engine.PayloadAttributes{
 		Timestamp:             timestamp,
 		SuggestedFeeRecipient: feeRecipient,
 		Withdrawals:           withdrawals,
 		Random:                random,
 		BeaconRoot:            &common.Hash{},
-	}, version, false)
+	}
+	if c.rollup != nil {
+		parent := c.eth.BlockChain().CurrentBlock()
+		deposits := c.deposits.pop(parent.Number.Uint64() + 1)
+
+		// Put the deposits back into the queue unless they made it into a block
+		defer func() {
+			if err != nil {
+				c.deposits.requeue(deposits)
+			}
+		}()
+		txs, err := c.rollup.transactions(c.eth.BlockChain(), parent, timestamp, deposits)
+		if err != nil {
+			return fmt.Errorf("failed to assemble deposits: %w", err)
+		}
+		gasLimit := parent.GasLimit
+		attributes.Transactions = txs
+		attributes.GasLimit = &gasLimit
+	}
+	fcResponse, err := c.engineAPI.forkchoiceUpdated(c.curForkchoiceState, attributes, version, false)
 	if err != nil {
 		return err
 	}
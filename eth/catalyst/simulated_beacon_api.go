@@ -18,6 +18,7 @@ package catalyst
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -50,10 +51,13 @@ func (a *simulatedBeaconAPI) loop() {
 		newWxs    = make(chan newWithdrawalsEvent)
 		newTxsSub = a.sim.eth.TxPool().SubscribeTransactions(newTxs, true)
 		newWxsSub = a.sim.withdrawals.subscribe(newWxs)
+		newDeps   = make(chan newDepositsEvent)
+		newDepSub = a.sim.deposits.subscribe(newDeps)
 		doCommit  = make(chan struct{}, 1)
 	)
 	defer newTxsSub.Unsubscribe()
 	defer newWxsSub.Unsubscribe()
+	defer newDepSub.Unsubscribe()
 
 	// A background thread which signals to the simulator when to commit
 	// based on messages over doCommit.
@@ -95,15 +99,32 @@ func (a *simulatedBeaconAPI) loop() {
 			case doCommit <- struct{}{}:
 			default:
 			}
+		case <-newDeps:
+			select {
+			case doCommit <- struct{}{}:
+			default:
+			}
 		}
 	}
 }
 
 // AddWithdrawal adds a withdrawal to the pending queue.
 func (a *simulatedBeaconAPI) AddWithdrawal(ctx context.Context, withdrawal *types.Withdrawal) error {
+	if a.sim.rollup != nil {
+		return errors.New("withdrawals are not supported in rollup dev mode")
+	}
 	return a.sim.withdrawals.add(withdrawal)
 }
 
+// AddDeposit adds a user deposit to the pending queue. It is only available
+// in rollup dev mode.
+func (a *simulatedBeaconAPI) AddDeposit(ctx context.Context, deposit DevDeposit) error {
+	if a.sim.rollup == nil {
+		return errors.New("deposits are only supported in rollup dev mode")
+	}
+	return a.sim.deposits.add(&deposit)
+}
+
 // SetFeeRecipient sets the fee recipient for block building purposes.
 func (a *simulatedBeaconAPI) SetFeeRecipient(ctx context.Context, feeRecipient common.Address) {
 	a.sim.setFeeRecipient(feeRecipient)
@@ -24,6 +24,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -208,3 +210,91 @@ func TestOnDemandSpam(t *testing.T) {
 		}
 	}
 }
+
+// Tests that the rollup dev mode starts every block with the L1 attributes
+// deposit, applies the L1 fee schedule and includes the queued deposits.
+func TestSimulatedBeaconRollup(t *testing.T) {
+	var (
+		testKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		testAddr   = crypto.PubkeyToAddress(testKey.PublicKey)
+		recipient  = common.HexToAddress("0x1234")
+		genesis    = core.DeveloperRollupGenesisBlock(30_000_000, &testAddr)
+	)
+	node, ethService, mock := startSimulatedBeaconEthService(t, genesis, 0)
+	defer node.Close()
+
+	l1BaseFee := big.NewInt(7_000_000_000)
+	ratio := (*math.HexOrDecimal256)(big.NewInt(4))
+	baseFee := (*math.HexOrDecimal256)(l1BaseFee)
+	err := mock.EnableRollup(&RollupDevConfig{
+		L1Info: []L1InfoScheduleEntry{
+			{Block: 2, TokenRatio: ratio},
+			{Block: 1, L1BaseFee: baseFee},
+		},
+		Deposits: []*DevDeposit{
+			{To: &recipient, Mint: (*hexutil.Big)(big.NewInt(100)), Value: (*hexutil.Big)(big.NewInt(100)), Gas: 100_000},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := ethService.BlockChain()
+
+	mock.Commit()
+	block := chain.GetBlockByNumber(1)
+	if txs := block.Transactions(); len(txs) != 2 || !txs[0].IsDepositTx() || *txs[0].To() != types.L1BlockAddr {
+		t.Fatalf("unexpected block 1 transactions: %v", txs)
+	}
+	statedb, _ := chain.StateAt(block.Root())
+	if have := statedb.GetBalance(recipient).Uint64(); have != 100 {
+		t.Errorf("deposit not applied: have balance %d, want 100", have)
+	}
+	if have := statedb.GetState(types.L1BlockAddr, types.L1BaseFeeSlot).Big(); have.Cmp(l1BaseFee) != 0 {
+		t.Errorf("wrong l1 base fee: have %v, want %v", have, l1BaseFee)
+	}
+
+	mock.Commit()
+	block = chain.GetBlockByNumber(2)
+	if txs := block.Transactions(); len(txs) != 2 || *txs[1].To() != types.GasOracleAddr {
+		t.Fatalf("unexpected block 2 transactions: %v", txs)
+	}
+	statedb, _ = chain.StateAt(block.Root())
+	if have := statedb.GetState(types.GasOracleAddr, types.TokenRatioSlot).Big(); have.Uint64() != 4 {
+		t.Errorf("wrong token ratio: have %v, want 4", have)
+	}
+	if have := statedb.GetState(types.L1BlockAddr, types.L1BaseFeeSlot).Big(); have.Cmp(l1BaseFee) != 0 {
+		t.Errorf("l1 base fee not retained: have %v, want %v", have, l1BaseFee)
+	}
+
+	// The token ratio is only updated once.
+	mock.Commit()
+	if txs := chain.GetBlockByNumber(3).Transactions(); len(txs) != 1 {
+		t.Fatalf("unexpected block 3 transactions: %v", txs)
+	}
+	receipts := chain.GetReceiptsByHash(chain.GetBlockByNumber(3).Hash())
+	if receipts[0].Status != types.ReceiptStatusSuccessful {
+		t.Errorf("l1 info deposit failed")
+	}
+
+	// Deposits are kept queued if the block they are due in fails to be built,
+	// here by not advancing the timestamp.
+	if err := mock.deposits.add(&DevDeposit{To: &recipient, Mint: (*hexutil.Big)(big.NewInt(50)), Value: (*hexutil.Big)(big.NewInt(50)), Gas: 100_000}); err != nil {
+		t.Fatal(err)
+	}
+	head := chain.CurrentBlock()
+	mock.lastBlockTime = head.Time - 1
+	if err := mock.sealBlock(nil, head.Time); err == nil {
+		t.Fatal("expected sealing with the parent timestamp to fail")
+	}
+	mock.lastBlockTime = head.Time
+
+	mock.Commit()
+	block = chain.GetBlockByNumber(4)
+	if block == nil || len(block.Transactions()) != 2 {
+		t.Fatalf("requeued deposit not included in block 4")
+	}
+	statedb, _ = chain.StateAt(block.Root())
+	if have := statedb.GetBalance(recipient).Uint64(); have != 150 {
+		t.Errorf("requeued deposit not applied: have balance %d, want 150", have)
+	}
+}
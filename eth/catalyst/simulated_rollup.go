@@ -0,0 +1,279 @@
+package catalyst
+
+import (
+	"cmp"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/preconf"
+)
+
+var (
+	// setL1BlockValuesSelector is the selector of L1Block.setL1BlockValues(uint64,
+	// uint64,uint256,bytes32,uint64,bytes32,uint256,uint256).
+	setL1BlockValuesSelector = crypto.Keccak256([]byte("setL1BlockValues(uint64,uint64,uint256,bytes32,uint64,bytes32,uint256,uint256)"))[:4]
+
+	// setTokenRatioSelector is the selector of GasPriceOracle.setTokenRatio(uint256).
+	setTokenRatioSelector = crypto.Keccak256([]byte("setTokenRatio(uint256)"))[:4]
+)
+
+// l1InfoDepositGas is the gas limit of the synthesized system deposits, in line
+// with the post-Regolith L1 attributes deposit.
+const l1InfoDepositGas = 1_000_000
+
+// L1InfoScheduleEntry sets the L1 fee parameters reported by the simulated
+// sequencer from the given L2 block onwards. Unset parameters keep their
+// current on-chain value.
+type L1InfoScheduleEntry struct {
+	Block      math.HexOrDecimal64   `json:"block"`
+	L1BaseFee  *math.HexOrDecimal256 `json:"l1BaseFee,omitempty"`
+	Overhead   *math.HexOrDecimal256 `json:"overhead,omitempty"`
+	Scalar     *math.HexOrDecimal256 `json:"scalar,omitempty"`
+	TokenRatio *math.HexOrDecimal256 `json:"tokenRatio,omitempty"`
+}
+
+// DevDeposit is a user deposit injected by the simulated sequencer.
+type DevDeposit struct {
+	// Block is the L2 block the deposit is included in. If unset, or already
+	// passed, the deposit is included in the next block.
+	Block *math.HexOrDecimal64 `json:"block,omitempty"`
+
+	From       common.Address  `json:"from"`
+	To         *common.Address `json:"to"`
+	Mint       *hexutil.Big    `json:"mint,omitempty"`
+	Value      *hexutil.Big    `json:"value,omitempty"`
+	Gas        hexutil.Uint64  `json:"gas"`
+	Data       hexutil.Bytes   `json:"data,omitempty"`
+	EthValue   *hexutil.Big    `json:"ethValue,omitempty"`
+	EthTxValue *hexutil.Big    `json:"ethTxValue,omitempty"`
+}
+
+// RollupDevConfig configures the simulated beacon to build blocks like the
+// Mantle sequencer: every block starts with an L1 attributes deposit, followed
+// by the tokenRatio update and the queued user deposits.
+type RollupDevConfig struct {
+	L1Info []L1InfoScheduleEntry `json:"l1Info"`
+
+	// GasPriceOracleOperator is the sender of the tokenRatio updates. It
+	// defaults to the L1 info depositor, which is the initial operator of the
+	// dev genesis GasPriceOracle. Any other operator has to be appointed by the
+	// owner of the GasPriceOracle through setOperator first.
+	GasPriceOracleOperator *common.Address `json:"gasPriceOracleOperator,omitempty"`
+
+	// Deposits are queued when the rollup mode is enabled.
+	Deposits []*DevDeposit `json:"deposits,omitempty"`
+}
+
+// LoadRollupDevConfig reads the rollup dev mode configuration from a JSON file.
+func LoadRollupDevConfig(file string) (*RollupDevConfig, error) {
+	blob, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	config := new(RollupDevConfig)
+	if err := json.Unmarshal(blob, config); err != nil {
+		return nil, fmt.Errorf("invalid rollup dev config %s: %w", file, err)
+	}
+	return config, nil
+}
+
+// depositQueue holds the user deposits that are pending inclusion.
+type depositQueue struct {
+	pending []*DevDeposit
+	mu      sync.Mutex
+	feed    event.Feed
+	subs    event.SubscriptionScope
+}
+
+type newDepositsEvent struct{ Deposits []*DevDeposit }
+
+// add queues a deposit for future inclusion.
+func (q *depositQueue) add(deposit *DevDeposit) error {
+	if deposit.To == nil && len(deposit.Data) == 0 {
+		return errors.New("deposit without recipient must carry init code")
+	}
+	q.mu.Lock()
+	q.pending = append(q.pending, deposit)
+	q.mu.Unlock()
+
+	q.feed.Send(newDepositsEvent{[]*DevDeposit{deposit}})
+	return nil
+}
+
+// pop dequeues the deposits due for inclusion in the given block.
+func (q *depositQueue) pop(number uint64) []*DevDeposit {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var due []*DevDeposit
+	q.pending = slices.DeleteFunc(q.pending, func(d *DevDeposit) bool {
+		if d.Block == nil || uint64(*d.Block) <= number {
+			due = append(due, d)
+			return true
+		}
+		return false
+	})
+	return due
+}
+
+// requeue puts back the deposits popped for a block which failed to be built,
+// keeping them ahead of the ones queued since.
+func (q *depositQueue) requeue(deposits []*DevDeposit) {
+	if len(deposits) == 0 {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.pending = append(slices.Clone(deposits), q.pending...)
+}
+
+// subscribe allows a listener to be updated when new deposits are added to
+// the queue.
+func (q *depositQueue) subscribe(ch chan<- newDepositsEvent) event.Subscription {
+	sub := q.feed.Subscribe(ch)
+	return q.subs.Track(sub)
+}
+
+// rollupSequencer synthesizes the deposit transactions of the simulated
+// rollup blocks.
+type rollupSequencer struct {
+	schedule []L1InfoScheduleEntry
+	operator common.Address
+}
+
+func newRollupSequencer(config *RollupDevConfig) *rollupSequencer {
+	schedule := slices.Clone(config.L1Info)
+	slices.SortStableFunc(schedule, func(a, b L1InfoScheduleEntry) int {
+		return cmp.Compare(a.Block, b.Block)
+	})
+	operator := types.L1InfoDepositerAddress
+	if config.GasPriceOracleOperator != nil {
+		operator = *config.GasPriceOracleOperator
+	}
+	return &rollupSequencer{schedule: schedule, operator: operator}
+}
+
+// transactions returns the encoded deposits forced into the block built on
+// top of parent.
+func (r *rollupSequencer) transactions(chain *core.BlockChain, parent *types.Header, timestamp uint64, deposits []*DevDeposit) ([][]byte, error) {
+	statedb, err := chain.StateAt(parent.Root)
+	if err != nil {
+		return nil, err
+	}
+	l1BaseFee, overhead, scalar, _, tokenRatio := types.DeriveL1GasInfo(statedb)
+	currentRatio := new(big.Int).Set(tokenRatio)
+
+	number := parent.Number.Uint64() + 1
+	for _, entry := range r.schedule {
+		if uint64(entry.Block) > number {
+			break
+		}
+		if entry.L1BaseFee != nil {
+			l1BaseFee = (*big.Int)(entry.L1BaseFee)
+		}
+		if entry.Overhead != nil {
+			overhead = (*big.Int)(entry.Overhead)
+		}
+		if entry.Scalar != nil {
+			scalar = (*big.Int)(entry.Scalar)
+		}
+		if entry.TokenRatio != nil {
+			tokenRatio = (*big.Int)(entry.TokenRatio)
+		}
+	}
+	// The simulated L1 chain advances in lockstep with L2, one L1 block per L2
+	// block and without sequencing epochs.
+	var l1Number [8]byte
+	binary.BigEndian.PutUint64(l1Number[:], number)
+	l1Hash := crypto.Keccak256Hash([]byte("mantle-dev-l1"), l1Number[:])
+
+	var (
+		regolith = chain.Config().IsRegolith(timestamp)
+		txs      []*types.Transaction
+	)
+	data := slices.Concat(setL1BlockValuesSelector,
+		abiWord(new(big.Int).SetUint64(number)),
+		abiWord(new(big.Int).SetUint64(timestamp)),
+		abiWord(l1BaseFee),
+		l1Hash[:],
+		abiWord(common.Big0),
+		common.Hash{}.Bytes(),
+		abiWord(overhead),
+		abiWord(scalar),
+	)
+	txs = append(txs, types.NewTx(&types.DepositTx{
+		SourceHash:          (&preconf.L1InfoDepositSource{L1BlockHash: l1Hash}).SourceHash(),
+		From:                types.L1InfoDepositerAddress,
+		To:                  &types.L1BlockAddr,
+		Value:               new(big.Int),
+		Gas:                 l1InfoDepositGas,
+		IsSystemTransaction: !regolith,
+		Data:                data,
+	}))
+
+	// User deposits are sourced from the logs of the simulated L1 block. The
+	// tokenRatio update, if any, occupies the first log.
+	var logIndex uint64
+	if tokenRatio.Cmp(currentRatio) != 0 {
+		txs = append(txs, types.NewTx(&types.DepositTx{
+			SourceHash:          (&preconf.UserDepositSource{L1BlockHash: l1Hash, LogIndex: logIndex}).SourceHash(),
+			From:                r.operator,
+			To:                  &types.GasOracleAddr,
+			Value:               new(big.Int),
+			Gas:                 l1InfoDepositGas,
+			IsSystemTransaction: !regolith,
+			Data:                slices.Concat(setTokenRatioSelector, abiWord(tokenRatio)),
+		}))
+		logIndex++
+	}
+	for _, d := range deposits {
+		dep := &types.DepositTx{
+			SourceHash: (&preconf.UserDepositSource{L1BlockHash: l1Hash, LogIndex: logIndex}).SourceHash(),
+			From:       d.From,
+			To:         d.To,
+			Value:      new(big.Int),
+			Gas:        uint64(d.Gas),
+			Data:       d.Data,
+		}
+		if d.Mint != nil {
+			dep.Mint = d.Mint.ToInt()
+		}
+		if d.Value != nil {
+			dep.Value = d.Value.ToInt()
+		}
+		if d.EthValue != nil {
+			dep.EthValue = d.EthValue.ToInt()
+		}
+		if d.EthTxValue != nil {
+			dep.EthTxValue = d.EthTxValue.ToInt()
+		}
+		txs = append(txs, types.NewTx(dep))
+		logIndex++
+	}
+	encoded := make([][]byte, len(txs))
+	for i, tx := range txs {
+		if encoded[i], err = tx.MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+	return encoded, nil
+}
+
+// abiWord left-pads the given value into a 32 byte ABI word.
+func abiWord(v *big.Int) []byte {
+	return common.BigToHash(v).Bytes()
+}
@@ -72,6 +72,13 @@ var Defaults = Config{
 	RPCEVMTimeout:      5 * time.Second,
 	GPO:                FullNodeGPO,
 	RPCTxFeeCap:        5000, // 5000 mnt
+
+	RPCBlockRangeLimit:       1000,
+	RPCBlockRangeConcurrency: 8,
+
+	RollupSequencerRetries:     3,
+	RollupSequencerHealthCheck: 10 * time.Second,
+	RollupHistoricalRPCCache:   64,
 }
 
 //go:generate go run github.com/fjl/gencodec -type Config -formats toml -out gen_config.go
@@ -107,6 +114,9 @@ type Config struct {
 	LogNoHistory         bool   `toml:",omitempty"` // No log search index is maintained.
 	LogExportCheckpoints string // export log index checkpoints to file
 	StateHistory         uint64 `toml:",omitempty"` // The maximum number of blocks from head whose state histories are reserved.
+	TrienodeHistory      bool   `toml:",omitempty"` // Whether the trie node histories are retained for historical proofs.
+	TraceIndex           bool   `toml:",omitempty"` // Whether the address index of the call traces is maintained for trace_filter.
+	TraceIndexHistory    uint64 `toml:",omitempty"` // The maximum number of blocks from head whose call traces are indexed.
 
 	// State scheme represents the scheme used to store ethereum states and trie
 	// nodes on top. It can be 'hash', 'path', or none which means use the scheme
@@ -174,6 +184,19 @@ type Config struct {
 	// send-transaction variants. The unit is ether.
 	RPCTxFeeCap float64
 
+	// RPCBlockRangeLimit is the maximum number of blocks past the first one
+	// returned at once by eth_getBlockRange. Zero selects the default limit.
+	RPCBlockRangeLimit uint64
+
+	// RPCBlockRangeConcurrency is the number of blocks fetched concurrently by
+	// eth_getBlockRange. Zero selects the default concurrency.
+	RPCBlockRangeConcurrency int
+
+	// RPCTokenRatioMargin is the relative token ratio increase eth_estimateGas
+	// prices its estimates with, so that they still cover the transaction if
+	// the token ratio moves before inclusion.
+	RPCTokenRatioMargin float64
+
 	// OverrideOsaka (TODO: remove after the fork)
 	OverrideOsaka *uint64 `toml:",omitempty"`
 
@@ -193,11 +216,27 @@ type Config struct {
 	// ApplyMantleUpgrades requests the node to update chain-configuration from the mantle config.
 	ApplyMantleUpgrades bool `toml:",omitempty"`
 
-	RollupSequencerHTTP          string
-	RollupHistoricalRPC          string
-	RollupHistoricalRPCTimeout   time.Duration
-	RollupDisableTxPoolGossip    bool
-	RollupDisableTxPoolAdmission bool
+	// MantleUpgradeConfig replaces the bundled Mantle upgrade schedule, allowing
+	// devnets to activate the Mantle forks at chosen times.
+	MantleUpgradeConfig *params.MantleUpgradeChainConfig `toml:",omitempty"`
+
+	OverrideMantleEverest *uint64 `toml:",omitempty"`
+	OverrideMantleSkadi   *uint64 `toml:",omitempty"`
+	OverrideMantleLimb    *uint64 `toml:",omitempty"`
+
+	RollupSequencerHTTP               string        // Comma separated list of sequencer endpoints, in order of preference
+	RollupSequencerRetries            int           // Number of times a failed forward to the sequencer is retried
+	RollupSequencerHealthCheck        time.Duration // Interval between sequencer health checks, zero disables them
+	RollupHistoricalRPC               string
+	RollupHistoricalRPCTimeout        time.Duration
+	RollupHistoricalRPCMethodTimeouts map[string]time.Duration `toml:",omitempty"` // Per-method time allowances of historical calls
+	RollupHistoricalRPCCache          int                      // Size of the historical response cache in megabytes
+	RollupDisableTxPoolGossip         bool
+	RollupDisableTxPoolAdmission      bool
+
+	// SimulatedRollup makes the simulated backend of ethclient/simulated build
+	// the blocks like the Mantle sequencer. It has no effect on a regular node.
+	SimulatedRollup bool `toml:"-"`
 }
 
 // CreateConsensusEngine creates a consensus engine for the given chain config.
@@ -13,65 +13,81 @@ import (
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
 	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // MarshalTOML marshals as TOML.
 func (c Config) MarshalTOML() (interface{}, error) {
 	type Config struct {
-		Genesis                      *core.Genesis `toml:",omitempty"`
-		NetworkId                    uint64
-		SyncMode                     SyncMode
-		HistoryMode                  history.HistoryMode
-		EthDiscoveryURLs             []string
-		SnapDiscoveryURLs            []string
-		NoPruning                    bool
-		NoPrefetch                   bool
-		TxLookupLimit                uint64 `toml:",omitempty"`
-		TransactionHistory           uint64 `toml:",omitempty"`
-		LogHistory                   uint64 `toml:",omitempty"`
-		LogNoHistory                 bool   `toml:",omitempty"`
-		LogExportCheckpoints         string
-		StateHistory                 uint64                 `toml:",omitempty"`
-		StateScheme                  string                 `toml:",omitempty"`
-		RequiredBlocks               map[uint64]common.Hash `toml:"-"`
-		SkipBcVersionCheck           bool                   `toml:"-"`
-		DatabaseHandles              int                    `toml:"-"`
-		DatabaseCache                int
-		DatabaseFreezer              string
-		DatabaseEra                  string
-		TrieCleanCache               int
-		TrieDirtyCache               int
-		TrieTimeout                  time.Duration
-		SnapshotCache                int
-		Preimages                    bool
-		FilterLogCacheSize           int
-		LogQueryLimit                int
-		Miner                        miner.Config
-		TxPool                       legacypool.Config
-		BlobPool                     blobpool.Config
-		GPO                          gasprice.Config
-		EnablePreimageRecording      bool
-		EnableWitnessStats           bool
-		StatelessSelfValidation      bool
-		EnableStateSizeTracking      bool
-		VMTrace                      string
-		VMTraceJsonConfig            string
-		RPCGasCap                    uint64
-		RPCEVMTimeout                time.Duration
-		RPCTxFeeCap                  float64
-		OverrideOsaka                *uint64 `toml:",omitempty"`
-		OverrideBPO1                 *uint64 `toml:",omitempty"`
-		OverrideBPO2                 *uint64 `toml:",omitempty"`
-		OverrideVerkle               *uint64 `toml:",omitempty"`
-		OverrideOptimismBedrock      *big.Int
-		OverrideOptimismRegolith     *uint64 `toml:",omitempty"`
-		OverrideOptimism             *bool
-		ApplyMantleUpgrades          bool `toml:",omitempty"`
-		RollupSequencerHTTP          string
-		RollupHistoricalRPC          string
-		RollupHistoricalRPCTimeout   time.Duration
-		RollupDisableTxPoolGossip    bool
-		RollupDisableTxPoolAdmission bool
+		Genesis                           *core.Genesis `toml:",omitempty"`
+		NetworkId                         uint64
+		SyncMode                          SyncMode
+		HistoryMode                       history.HistoryMode
+		EthDiscoveryURLs                  []string
+		SnapDiscoveryURLs                 []string
+		NoPruning                         bool
+		NoPrefetch                        bool
+		TxLookupLimit                     uint64 `toml:",omitempty"`
+		TransactionHistory                uint64 `toml:",omitempty"`
+		LogHistory                        uint64 `toml:",omitempty"`
+		LogNoHistory                      bool   `toml:",omitempty"`
+		LogExportCheckpoints              string
+		StateHistory                      uint64                 `toml:",omitempty"`
+		TrienodeHistory                   bool                   `toml:",omitempty"`
+		TraceIndex                        bool                   `toml:",omitempty"`
+		TraceIndexHistory                 uint64                 `toml:",omitempty"`
+		StateScheme                       string                 `toml:",omitempty"`
+		RequiredBlocks                    map[uint64]common.Hash `toml:"-"`
+		SkipBcVersionCheck                bool                   `toml:"-"`
+		DatabaseHandles                   int                    `toml:"-"`
+		DatabaseCache                     int
+		DatabaseFreezer                   string
+		DatabaseEra                       string
+		TrieCleanCache                    int
+		TrieDirtyCache                    int
+		TrieTimeout                       time.Duration
+		SnapshotCache                     int
+		Preimages                         bool
+		FilterLogCacheSize                int
+		LogQueryLimit                     int
+		Miner                             miner.Config
+		TxPool                            legacypool.Config
+		BlobPool                          blobpool.Config
+		GPO                               gasprice.Config
+		EnablePreimageRecording           bool
+		EnableWitnessStats                bool
+		StatelessSelfValidation           bool
+		EnableStateSizeTracking           bool
+		VMTrace                           string
+		VMTraceJsonConfig                 string
+		RPCGasCap                         uint64
+		RPCEVMTimeout                     time.Duration
+		RPCTxFeeCap                       float64
+		RPCBlockRangeLimit                uint64
+		RPCBlockRangeConcurrency          int
+		RPCTokenRatioMargin               float64
+		OverrideOsaka                     *uint64 `toml:",omitempty"`
+		OverrideBPO1                      *uint64 `toml:",omitempty"`
+		OverrideBPO2                      *uint64 `toml:",omitempty"`
+		OverrideVerkle                    *uint64 `toml:",omitempty"`
+		OverrideOptimismBedrock           *big.Int
+		OverrideOptimismRegolith          *uint64 `toml:",omitempty"`
+		OverrideOptimism                  *bool
+		ApplyMantleUpgrades               bool                             `toml:",omitempty"`
+		MantleUpgradeConfig               *params.MantleUpgradeChainConfig `toml:",omitempty"`
+		OverrideMantleEverest             *uint64                          `toml:",omitempty"`
+		OverrideMantleSkadi               *uint64                          `toml:",omitempty"`
+		OverrideMantleLimb                *uint64                          `toml:",omitempty"`
+		RollupSequencerHTTP               string
+		RollupSequencerRetries            int
+		RollupSequencerHealthCheck        time.Duration
+		RollupHistoricalRPC               string
+		RollupHistoricalRPCTimeout        time.Duration
+		RollupHistoricalRPCMethodTimeouts map[string]time.Duration `toml:",omitempty"`
+		RollupHistoricalRPCCache          int
+		RollupDisableTxPoolGossip         bool
+		RollupDisableTxPoolAdmission      bool
+		SimulatedRollup                   bool `toml:"-"`
 	}
 	var enc Config
 	enc.Genesis = c.Genesis
@@ -88,6 +104,9 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.LogNoHistory = c.LogNoHistory
 	enc.LogExportCheckpoints = c.LogExportCheckpoints
 	enc.StateHistory = c.StateHistory
+	enc.TrienodeHistory = c.TrienodeHistory
+	enc.TraceIndex = c.TraceIndex
+	enc.TraceIndexHistory = c.TraceIndexHistory
 	enc.StateScheme = c.StateScheme
 	enc.RequiredBlocks = c.RequiredBlocks
 	enc.SkipBcVersionCheck = c.SkipBcVersionCheck
@@ -115,6 +134,9 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.RPCGasCap = c.RPCGasCap
 	enc.RPCEVMTimeout = c.RPCEVMTimeout
 	enc.RPCTxFeeCap = c.RPCTxFeeCap
+	enc.RPCBlockRangeLimit = c.RPCBlockRangeLimit
+	enc.RPCBlockRangeConcurrency = c.RPCBlockRangeConcurrency
+	enc.RPCTokenRatioMargin = c.RPCTokenRatioMargin
 	enc.OverrideOsaka = c.OverrideOsaka
 	enc.OverrideBPO1 = c.OverrideBPO1
 	enc.OverrideBPO2 = c.OverrideBPO2
@@ -123,71 +145,95 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.OverrideOptimismRegolith = c.OverrideOptimismRegolith
 	enc.OverrideOptimism = c.OverrideOptimism
 	enc.ApplyMantleUpgrades = c.ApplyMantleUpgrades
+	enc.MantleUpgradeConfig = c.MantleUpgradeConfig
+	enc.OverrideMantleEverest = c.OverrideMantleEverest
+	enc.OverrideMantleSkadi = c.OverrideMantleSkadi
+	enc.OverrideMantleLimb = c.OverrideMantleLimb
 	enc.RollupSequencerHTTP = c.RollupSequencerHTTP
+	enc.RollupSequencerRetries = c.RollupSequencerRetries
+	enc.RollupSequencerHealthCheck = c.RollupSequencerHealthCheck
 	enc.RollupHistoricalRPC = c.RollupHistoricalRPC
 	enc.RollupHistoricalRPCTimeout = c.RollupHistoricalRPCTimeout
+	enc.RollupHistoricalRPCMethodTimeouts = c.RollupHistoricalRPCMethodTimeouts
+	enc.RollupHistoricalRPCCache = c.RollupHistoricalRPCCache
 	enc.RollupDisableTxPoolGossip = c.RollupDisableTxPoolGossip
 	enc.RollupDisableTxPoolAdmission = c.RollupDisableTxPoolAdmission
+	enc.SimulatedRollup = c.SimulatedRollup
 	return &enc, nil
 }
 
 // UnmarshalTOML unmarshals from TOML.
 func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	type Config struct {
-		Genesis                      *core.Genesis `toml:",omitempty"`
-		NetworkId                    *uint64
-		SyncMode                     *SyncMode
-		HistoryMode                  *history.HistoryMode
-		EthDiscoveryURLs             []string
-		SnapDiscoveryURLs            []string
-		NoPruning                    *bool
-		NoPrefetch                   *bool
-		TxLookupLimit                *uint64 `toml:",omitempty"`
-		TransactionHistory           *uint64 `toml:",omitempty"`
-		LogHistory                   *uint64 `toml:",omitempty"`
-		LogNoHistory                 *bool   `toml:",omitempty"`
-		LogExportCheckpoints         *string
-		StateHistory                 *uint64                `toml:",omitempty"`
-		StateScheme                  *string                `toml:",omitempty"`
-		RequiredBlocks               map[uint64]common.Hash `toml:"-"`
-		SkipBcVersionCheck           *bool                  `toml:"-"`
-		DatabaseHandles              *int                   `toml:"-"`
-		DatabaseCache                *int
-		DatabaseFreezer              *string
-		DatabaseEra                  *string
-		TrieCleanCache               *int
-		TrieDirtyCache               *int
-		TrieTimeout                  *time.Duration
-		SnapshotCache                *int
-		Preimages                    *bool
-		FilterLogCacheSize           *int
-		LogQueryLimit                *int
-		Miner                        *miner.Config
-		TxPool                       *legacypool.Config
-		BlobPool                     *blobpool.Config
-		GPO                          *gasprice.Config
-		EnablePreimageRecording      *bool
-		EnableWitnessStats           *bool
-		StatelessSelfValidation      *bool
-		EnableStateSizeTracking      *bool
-		VMTrace                      *string
-		VMTraceJsonConfig            *string
-		RPCGasCap                    *uint64
-		RPCEVMTimeout                *time.Duration
-		RPCTxFeeCap                  *float64
-		OverrideOsaka                *uint64 `toml:",omitempty"`
-		OverrideBPO1                 *uint64 `toml:",omitempty"`
-		OverrideBPO2                 *uint64 `toml:",omitempty"`
-		OverrideVerkle               *uint64 `toml:",omitempty"`
-		OverrideOptimismBedrock      *big.Int
-		OverrideOptimismRegolith     *uint64 `toml:",omitempty"`
-		OverrideOptimism             *bool
-		ApplyMantleUpgrades          *bool `toml:",omitempty"`
-		RollupSequencerHTTP          *string
-		RollupHistoricalRPC          *string
-		RollupHistoricalRPCTimeout   *time.Duration
-		RollupDisableTxPoolGossip    *bool
-		RollupDisableTxPoolAdmission *bool
+		Genesis                           *core.Genesis `toml:",omitempty"`
+		NetworkId                         *uint64
+		SyncMode                          *SyncMode
+		HistoryMode                       *history.HistoryMode
+		EthDiscoveryURLs                  []string
+		SnapDiscoveryURLs                 []string
+		NoPruning                         *bool
+		NoPrefetch                        *bool
+		TxLookupLimit                     *uint64 `toml:",omitempty"`
+		TransactionHistory                *uint64 `toml:",omitempty"`
+		LogHistory                        *uint64 `toml:",omitempty"`
+		LogNoHistory                      *bool   `toml:",omitempty"`
+		LogExportCheckpoints              *string
+		StateHistory                      *uint64                `toml:",omitempty"`
+		TrienodeHistory                   *bool                  `toml:",omitempty"`
+		TraceIndex                        *bool                  `toml:",omitempty"`
+		TraceIndexHistory                 *uint64                `toml:",omitempty"`
+		StateScheme                       *string                `toml:",omitempty"`
+		RequiredBlocks                    map[uint64]common.Hash `toml:"-"`
+		SkipBcVersionCheck                *bool                  `toml:"-"`
+		DatabaseHandles                   *int                   `toml:"-"`
+		DatabaseCache                     *int
+		DatabaseFreezer                   *string
+		DatabaseEra                       *string
+		TrieCleanCache                    *int
+		TrieDirtyCache                    *int
+		TrieTimeout                       *time.Duration
+		SnapshotCache                     *int
+		Preimages                         *bool
+		FilterLogCacheSize                *int
+		LogQueryLimit                     *int
+		Miner                             *miner.Config
+		TxPool                            *legacypool.Config
+		BlobPool                          *blobpool.Config
+		GPO                               *gasprice.Config
+		EnablePreimageRecording           *bool
+		EnableWitnessStats                *bool
+		StatelessSelfValidation           *bool
+		EnableStateSizeTracking           *bool
+		VMTrace                           *string
+		VMTraceJsonConfig                 *string
+		RPCGasCap                         *uint64
+		RPCEVMTimeout                     *time.Duration
+		RPCTxFeeCap                       *float64
+		RPCBlockRangeLimit                *uint64
+		RPCBlockRangeConcurrency          *int
+		RPCTokenRatioMargin               *float64
+		OverrideOsaka                     *uint64 `toml:",omitempty"`
+		OverrideBPO1                      *uint64 `toml:",omitempty"`
+		OverrideBPO2                      *uint64 `toml:",omitempty"`
+		OverrideVerkle                    *uint64 `toml:",omitempty"`
+		OverrideOptimismBedrock           *big.Int
+		OverrideOptimismRegolith          *uint64 `toml:",omitempty"`
+		OverrideOptimism                  *bool
+		ApplyMantleUpgrades               *bool                            `toml:",omitempty"`
+		MantleUpgradeConfig               *params.MantleUpgradeChainConfig `toml:",omitempty"`
+		OverrideMantleEverest             *uint64                          `toml:",omitempty"`
+		OverrideMantleSkadi               *uint64                          `toml:",omitempty"`
+		OverrideMantleLimb                *uint64                          `toml:",omitempty"`
+		RollupSequencerHTTP               *string
+		RollupSequencerRetries            *int
+		RollupSequencerHealthCheck        *time.Duration
+		RollupHistoricalRPC               *string
+		RollupHistoricalRPCTimeout        *time.Duration
+		RollupHistoricalRPCMethodTimeouts map[string]time.Duration `toml:",omitempty"`
+		RollupHistoricalRPCCache          *int
+		RollupDisableTxPoolGossip         *bool
+		RollupDisableTxPoolAdmission      *bool
+		SimulatedRollup                   *bool `toml:"-"`
 	}
 	var dec Config
 	if err := unmarshal(&dec); err != nil {
@@ -235,6 +281,15 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.StateHistory != nil {
 		c.StateHistory = *dec.StateHistory
 	}
+	if dec.TrienodeHistory != nil {
+		c.TrienodeHistory = *dec.TrienodeHistory
+	}
+	if dec.TraceIndex != nil {
+		c.TraceIndex = *dec.TraceIndex
+	}
+	if dec.TraceIndexHistory != nil {
+		c.TraceIndexHistory = *dec.TraceIndexHistory
+	}
 	if dec.StateScheme != nil {
 		c.StateScheme = *dec.StateScheme
 	}
@@ -316,6 +371,15 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.RPCTxFeeCap != nil {
 		c.RPCTxFeeCap = *dec.RPCTxFeeCap
 	}
+	if dec.RPCBlockRangeLimit != nil {
+		c.RPCBlockRangeLimit = *dec.RPCBlockRangeLimit
+	}
+	if dec.RPCBlockRangeConcurrency != nil {
+		c.RPCBlockRangeConcurrency = *dec.RPCBlockRangeConcurrency
+	}
+	if dec.RPCTokenRatioMargin != nil {
+		c.RPCTokenRatioMargin = *dec.RPCTokenRatioMargin
+	}
 	if dec.OverrideOsaka != nil {
 		c.OverrideOsaka = dec.OverrideOsaka
 	}
@@ -340,20 +404,47 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.ApplyMantleUpgrades != nil {
 		c.ApplyMantleUpgrades = *dec.ApplyMantleUpgrades
 	}
+	if dec.MantleUpgradeConfig != nil {
+		c.MantleUpgradeConfig = dec.MantleUpgradeConfig
+	}
+	if dec.OverrideMantleEverest != nil {
+		c.OverrideMantleEverest = dec.OverrideMantleEverest
+	}
+	if dec.OverrideMantleSkadi != nil {
+		c.OverrideMantleSkadi = dec.OverrideMantleSkadi
+	}
+	if dec.OverrideMantleLimb != nil {
+		c.OverrideMantleLimb = dec.OverrideMantleLimb
+	}
 	if dec.RollupSequencerHTTP != nil {
 		c.RollupSequencerHTTP = *dec.RollupSequencerHTTP
 	}
+	if dec.RollupSequencerRetries != nil {
+		c.RollupSequencerRetries = *dec.RollupSequencerRetries
+	}
+	if dec.RollupSequencerHealthCheck != nil {
+		c.RollupSequencerHealthCheck = *dec.RollupSequencerHealthCheck
+	}
 	if dec.RollupHistoricalRPC != nil {
 		c.RollupHistoricalRPC = *dec.RollupHistoricalRPC
 	}
 	if dec.RollupHistoricalRPCTimeout != nil {
 		c.RollupHistoricalRPCTimeout = *dec.RollupHistoricalRPCTimeout
 	}
+	if dec.RollupHistoricalRPCMethodTimeouts != nil {
+		c.RollupHistoricalRPCMethodTimeouts = dec.RollupHistoricalRPCMethodTimeouts
+	}
+	if dec.RollupHistoricalRPCCache != nil {
+		c.RollupHistoricalRPCCache = *dec.RollupHistoricalRPCCache
+	}
 	if dec.RollupDisableTxPoolGossip != nil {
 		c.RollupDisableTxPoolGossip = *dec.RollupDisableTxPoolGossip
 	}
 	if dec.RollupDisableTxPoolAdmission != nil {
 		c.RollupDisableTxPoolAdmission = *dec.RollupDisableTxPoolAdmission
 	}
+	if dec.SimulatedRollup != nil {
+		c.SimulatedRollup = *dec.SimulatedRollup
+	}
 	return nil
 }
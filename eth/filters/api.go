@@ -45,6 +45,7 @@ var (
 	errExceedMaxTopics        = errors.New("exceed max topics")
 	errExceedLogQueryLimit    = errors.New("exceed max addresses or topics per search position")
 	errExceedMaxTxHashes      = errors.New("exceed max number of transaction hashes allowed per transactionReceipts subscription")
+	errExceedMaxPreconfHashes = errors.New("exceed max number of transaction hashes allowed per newPreconfTransaction subscription")
 )
 
 const (
@@ -206,19 +207,36 @@ func (api *FilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool)
 	return rpcSub, nil
 }
 
+// PreconfTransactionsQuery defines criteria for the preconf transactions
+// subscription. Empty fields match every preconf transaction.
+type PreconfTransactionsQuery struct {
+	TransactionHashes []common.Hash        `json:"transactionHashes"`
+	Statuses          []core.PreconfStatus `json:"statuses"`
+}
+
 // NewPreconfTransaction creates a subscription that is triggered each time a
-// preconf transaction enters the transaction pool.
-func (api *FilterAPI) NewPreconfTransaction(ctx context.Context) (*rpc.Subscription, error) {
+// preconf transaction enters the transaction pool. If a filter is given, only
+// the preconf results matching it are delivered.
+func (api *FilterAPI) NewPreconfTransaction(ctx context.Context, filter *PreconfTransactionsQuery) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
-
+	var (
+		txHashes []common.Hash
+		statuses []core.PreconfStatus
+	)
+	if filter != nil {
+		if len(filter.TransactionHashes) > maxTxHashes {
+			return nil, errExceedMaxPreconfHashes
+		}
+		txHashes, statuses = filter.TransactionHashes, filter.Statuses
+	}
 	rpcSub := notifier.CreateSubscription()
 
 	go func() {
 		preconfTx := make(chan core.NewPreconfTxEvent, txChanSize)
-		preconfTxSub := api.events.SubscribePreconfTxs(preconfTx)
+		preconfTxSub := api.events.SubscribePreconfTxs(txHashes, statuses, preconfTx)
 		defer preconfTxSub.Unsubscribe()
 
 		for {
@@ -619,3 +619,15 @@ func filterReceipts(txHashes []common.Hash, ev core.ChainEvent) []*ReceiptWithTx
 
 	return ret
 }
+
+// filterPreconfTx reports whether the preconf transaction event matches the
+// given criteria. Empty criteria match every event.
+func filterPreconfTx(txHashes []common.Hash, statuses []core.PreconfStatus, ev core.NewPreconfTxEvent) bool {
+	if len(txHashes) > 0 && !slices.Contains(txHashes, ev.TxHash) {
+		return false
+	}
+	if len(statuses) > 0 && !slices.Contains(statuses, ev.Status) {
+		return false
+	}
+	return true
+}
@@ -190,9 +190,10 @@ type subscription struct {
 	preconfTx chan core.NewPreconfTxEvent
 	headers   chan *types.Header
 	receipts  chan []*ReceiptWithTx
-	txHashes  []common.Hash // contains transaction hashes for transactionReceipts subscription filtering
-	installed chan struct{} // closed when the filter is installed
-	err       chan error    // closed when the filter is uninstalled
+	txHashes  []common.Hash        // contains transaction hashes for transactionReceipts and preconf subscription filtering
+	statuses  []core.PreconfStatus // contains preconf statuses for preconf subscription filtering
+	installed chan struct{}        // closed when the filter is installed
+	err       chan error           // closed when the filter is uninstalled
 }
 
 // EventSystem creates subscriptions, processes events and broadcasts them to the
@@ -414,8 +415,9 @@ func (es *EventSystem) SubscribePendingTxs(txs chan []*types.Transaction) *Subsc
 // SubscribePreconfTxs creates a subscription that writes transactions for
 // pre-confirmed transactions that enter the transaction pool in FIFO order.
 // These transactions are processed in the order they were received, ensuring
-// deterministic execution order for pre-confirmed transactions.
-func (es *EventSystem) SubscribePreconfTxs(preconfTx chan core.NewPreconfTxEvent) *Subscription {
+// deterministic execution order for pre-confirmed transactions. If txHashes or
+// statuses are provided, only the matching events will be delivered.
+func (es *EventSystem) SubscribePreconfTxs(txHashes []common.Hash, statuses []core.PreconfStatus, preconfTx chan core.NewPreconfTxEvent) *Subscription {
 	sub := &subscription{
 		id:        rpc.NewID(),
 		typ:       PreconfTransactionsSubscription,
@@ -425,6 +427,8 @@ func (es *EventSystem) SubscribePreconfTxs(preconfTx chan core.NewPreconfTxEvent
 		preconfTx: preconfTx,
 		headers:   make(chan *types.Header),
 		receipts:  make(chan []*ReceiptWithTx),
+		txHashes:  txHashes,
+		statuses:  statuses,
 		installed: make(chan struct{}),
 		err:       make(chan error),
 	}
@@ -466,7 +470,9 @@ func (es *EventSystem) handleLogs(filters filterIndex, ev []*types.Log) {
 
 func (es *EventSystem) handlePreconfTxEvent(filters filterIndex, ev core.NewPreconfTxEvent) {
 	for _, f := range filters[PreconfTransactionsSubscription] {
-		f.preconfTx <- ev
+		if filterPreconfTx(f.txHashes, f.statuses, ev) {
+			f.preconfTx <- ev
+		}
 	}
 }
 
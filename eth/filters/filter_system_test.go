@@ -22,6 +22,7 @@ import (
 	"math/big"
 	"reflect"
 	"runtime"
+	"slices"
 	"testing"
 	"time"
 
@@ -927,3 +928,71 @@ func TestTransactionReceiptsSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestPreconfTxSubscription(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(db, Config{})
+		api          = NewFilterAPI(sys)
+		events       = []core.NewPreconfTxEvent{
+			{TxHash: common.Hash{1}, Status: core.PreconfStatusSuccess},
+			{TxHash: common.Hash{2}, Status: core.PreconfStatusFailed},
+			{TxHash: common.Hash{3}, Status: core.PreconfStatusSuccess},
+		}
+	)
+	testCases := []struct {
+		name     string
+		txHashes []common.Hash
+		statuses []core.PreconfStatus
+		expected []common.Hash
+	}{
+		{
+			name:     "no filter",
+			expected: []common.Hash{{1}, {2}, {3}},
+		},
+		{
+			name:     "tx hash filter",
+			txHashes: []common.Hash{{2}, {3}},
+			expected: []common.Hash{{2}, {3}},
+		},
+		{
+			name:     "status filter",
+			statuses: []core.PreconfStatus{core.PreconfStatusFailed},
+			expected: []common.Hash{{2}},
+		},
+		{
+			name:     "tx hash and status filter",
+			txHashes: []common.Hash{{1}, {2}},
+			statuses: []core.PreconfStatus{core.PreconfStatusSuccess},
+			expected: []common.Hash{{1}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := make(chan core.NewPreconfTxEvent, len(events))
+			sub := api.events.SubscribePreconfTxs(tc.txHashes, tc.statuses, ch)
+
+			for _, ev := range events {
+				backend.preconfTxFeed.Send(ev)
+			}
+
+			var received []common.Hash
+			timeout := time.After(time.Second)
+			for len(received) < len(tc.expected) {
+				select {
+				case ev := <-ch:
+					received = append(received, ev.TxHash)
+				case <-timeout:
+					t.Fatalf("timeout waiting for preconf events, have %v", received)
+				}
+			}
+			if !slices.Equal(received, tc.expected) {
+				t.Errorf("have events %v, want %v", received, tc.expected)
+			}
+			sub.Unsubscribe()
+			<-sub.Err()
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -46,19 +47,107 @@ type Options struct {
 	ErrorRatio float64 // Allowed overestimation ratio for faster estimation termination
 
 	DefaultGasPriceForEstimate *big.Int
+
+	L1FeeParams      *types.L1FeeParams // Fee oracle values to price the estimate with, read from State if nil
+	TokenRatioMargin float64            // Relative token ratio increase to guard against oracle updates before inclusion
+}
+
+// Estimation is the outcome of a gas estimation. On Mantle, the gas limit of a
+// transaction has to cover its execution gas scaled by the token ratio, plus
+// the L1 data fee expressed in gas. The parts are reported separately, so that
+// callers can re-price an estimate if the token ratio moves.
+type Estimation struct {
+	Gas          uint64   // Gas limit the transaction should be sent with
+	ExecutionGas uint64   // Intrinsic and execution gas, not scaled by the token ratio
+	L1Gas        uint64   // Gas covering the L1 data fee
+	TokenRatio   *big.Int // Token ratio the estimate is priced with, including any margin
 }
 
+// errGasAllowance is returned by search if the transaction fails even at the
+// highest allowed gas limit.
+var errGasAllowance = errors.New("gas required exceeds allowance")
+
 // Estimate returns the lowest possible gas limit that allows the transaction to
 // run successfully with the provided context options. It returns an error if the
 // transaction would always revert, or if there are unexpected failures.
 func Estimate(ctx context.Context, call *core.Message, opts *Options, gasCap uint64) (uint64, []byte, error) {
-	// Binary search the gas limit, as it may need to be higher than the amount used
+	estimate, revert, err := EstimateBreakdown(ctx, call, opts, gasCap)
+	if err != nil {
+		return 0, revert, err
+	}
+	return estimate.Gas, nil, nil
+}
+
+// EstimateBreakdown is like Estimate, but reports the parts the gas limit is made
+// of. On Mantle, the gas limit is searched for against the fee oracle values of
+// the state, the execution gas is then derived from it and scaled again by the
+// (optionally margined) token ratio from the fee oracle values in the options.
+func EstimateBreakdown(ctx context.Context, call *core.Message, opts *Options, gasCap uint64) (*Estimation, []byte, error) {
+	hi, err := allowance(call, opts, gasCap)
+	if err != nil {
+		return nil, nil, err
+	}
+	gas, revert, err := search(ctx, call, opts, hi)
+	if err != nil {
+		if errors.Is(err, errGasAllowance) {
+			err = fmt.Errorf("%w (%d)", err, hi)
+		}
+		return nil, revert, err
+	}
+	if !opts.Config.IsOptimism() {
+		return &Estimation{Gas: gas, ExecutionGas: gas}, nil, nil
+	}
+	// Strip the L1 data fee and the token ratio the state transition charged
+	// from the gas limit found, leaving the unscaled execution gas.
 	var (
-		lo uint64 // lowest-known gas limit where tx execution fails
-		hi uint64 // lowest-known gas limit where tx execution succeeds
+		state      = types.ReadL1FeeParams(opts.State)
+		stateRatio = state.TokenRatio.Uint64()
+		execGas    = gas - min(gas, l1DataGas(call, opts, state, state.TokenRatio, gas))
 	)
+	if stateRatio > 0 {
+		execGas = (execGas + stateRatio - 1) / stateRatio
+	} else {
+		// A zero token ratio disables the scaling in the state transition,
+		// including the intrinsic gas, which is then not part of the search.
+		intrinsic, err := intrinsicGas(call, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		execGas += intrinsic
+	}
+	fees := opts.L1FeeParams
+	if fees == nil {
+		fees = state
+	}
+	// Estimating with a ratio of one in place of a zero one overshoots the
+	// intrinsic gas only.
+	ratio := fees.TokenRatio.Uint64()
+	if ratio == 0 {
+		ratio = 1
+	}
+	if opts.TokenRatioMargin > 0 {
+		ratio += uint64(math.Ceil(float64(ratio) * opts.TokenRatioMargin))
+	}
+	tokenRatio := new(big.Int).SetUint64(ratio)
+
+	// Price the L1 data fee with the gas limit at its cap, which overshoots the
+	// encoded size of the final gas limit, if anything.
+	l1Gas := l1DataGas(call, opts, fees, tokenRatio, hi)
+	if l1Gas >= hi || execGas > (hi-l1Gas)/ratio {
+		return nil, nil, fmt.Errorf("%w (%d)", errGasAllowance, hi)
+	}
+	return &Estimation{
+		Gas:          execGas*ratio + l1Gas,
+		ExecutionGas: execGas,
+		L1Gas:        l1Gas,
+		TokenRatio:   tokenRatio,
+	}, nil, nil
+}
+
+// allowance returns the highest gas limit that can be used during the estimation.
+func allowance(call *core.Message, opts *Options, gasCap uint64) (uint64, error) {
 	// Determine the highest gas limit can be used during the estimation.
-	hi = opts.Header.GasLimit
+	hi := opts.Header.GasLimit
 	if call.GasLimit >= params.TxGas {
 		hi = call.GasLimit
 	}
@@ -95,7 +184,7 @@ func Estimate(ctx context.Context, call *core.Message, opts *Options, gasCap uin
 		available := balance
 		if call.Value != nil {
 			if call.Value.Cmp(available) >= 0 {
-				return 0, nil, core.ErrInsufficientFundsForTransfer
+				return 0, core.ErrInsufficientFundsForTransfer
 			}
 			available.Sub(available, call.Value)
 		}
@@ -105,7 +194,7 @@ func Estimate(ctx context.Context, call *core.Message, opts *Options, gasCap uin
 			blobBalanceUsage.Mul(blobBalanceUsage, blobGasPerBlob)
 			blobBalanceUsage.Mul(blobBalanceUsage, call.BlobGasFeeCap)
 			if blobBalanceUsage.Cmp(available) >= 0 {
-				return 0, nil, core.ErrInsufficientFunds
+				return 0, core.ErrInsufficientFunds
 			}
 			available.Sub(available, blobBalanceUsage)
 		}
@@ -127,6 +216,14 @@ func Estimate(ctx context.Context, call *core.Message, opts *Options, gasCap uin
 		log.Debug("Caller gas above allowance, capping", "requested", hi, "cap", gasCap)
 		hi = gasCap
 	}
+	return hi, nil
+}
+
+// search binary searches the lowest gas limit up to hi that allows the
+// transaction to run successfully.
+func search(ctx context.Context, call *core.Message, opts *Options, hi uint64) (uint64, []byte, error) {
+	var lo uint64 // lowest-known gas limit where tx execution fails
+
 	// If the transaction is a plain value transfer, short circuit estimation and
 	// directly try 21000. Returning 21000 without any execution is dangerous as
 	// some tx field combos might bump the price up even for plain transfers (e.g.
@@ -149,7 +246,7 @@ func Estimate(ctx context.Context, call *core.Message, opts *Options, gasCap uin
 		if result != nil && !errors.Is(result.Err, vm.ErrOutOfGas) {
 			return 0, result.Revert(), result.Err
 		}
-		return 0, nil, fmt.Errorf("gas required exceeds allowance (%d)", hi)
+		return 0, nil, errGasAllowance
 	}
 	// For almost any transaction, the gas consumed by the unconstrained execution
 	// above lower-bounds the gas limit required for it to succeed. One exception
@@ -268,7 +365,7 @@ func run(ctx context.Context, call *core.Message, opts *Options) (*core.Executio
 		evm.Cancel()
 	}()
 	// Execute the call, returning a wrapped error or the result
-	result, err := core.ApplyMessage(evm, call, new(core.GasPool).AddGas(core.DefaultMantleBlockGasLimit))
+	result, err := core.ApplyMessage(evm, call, new(core.GasPool).AddGas(math.MaxUint64))
 	if vmerr := dirtyState.Error(); vmerr != nil {
 		return nil, vmerr
 	}
@@ -277,3 +374,24 @@ func run(ctx context.Context, call *core.Message, opts *Options) (*core.Executio
 	}
 	return result, nil
 }
+
+// l1DataGas returns the gas charged to cover the L1 data fee of the transaction
+// sent with the given gas limit, priced with the given fee oracle values.
+func l1DataGas(call *core.Message, opts *Options, fees *types.L1FeeParams, tokenRatio *big.Int, gasLimit uint64) uint64 {
+	if call.GasPrice == nil || call.GasPrice.Sign() == 0 {
+		return 0
+	}
+	defer func(gas uint64) { call.GasLimit = gas }(call.GasLimit)
+	call.GasLimit = gasLimit
+
+	dataGas := core.EstimateRollupCostData(call).DataGas(opts.Header.Time, opts.Config)
+	cost := types.L1Cost(dataGas, fees.L1BaseFee, fees.Overhead, fees.Scalar, tokenRatio)
+	return cost.Div(cost, call.GasPrice).Uint64()
+}
+
+// intrinsicGas returns the intrinsic gas of the transaction, not scaled by the
+// token ratio.
+func intrinsicGas(call *core.Message, opts *Options) (uint64, error) {
+	rules := opts.Config.Rules(opts.Header.Number, opts.Config.IsPostMerge(opts.Header.Number.Uint64(), opts.Header.Time), opts.Header.Time)
+	return core.IntrinsicGas(call.Data, call.AccessList, call.SetCodeAuthorizations, call.To == nil, rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai)
+}
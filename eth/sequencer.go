@@ -0,0 +1,265 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// sequencerHealthTimeout is the time allowance for a single health check.
+	sequencerHealthTimeout = 5 * time.Second
+
+	// sequencerRetryDelay is the delay before the first retry of a failed
+	// forward, doubled on every subsequent retry.
+	sequencerRetryDelay = 100 * time.Millisecond
+)
+
+// errAlreadyForwarded is returned if a retried forward is rejected because an
+// earlier attempt did reach the sequencer.
+var errAlreadyForwarded = errors.New("transaction already forwarded")
+
+// sequencerEndpoint is a single sequencer RPC endpoint along with its health
+// and metrics.
+type sequencerEndpoint struct {
+	url     string
+	client  *rpc.Client
+	healthy atomic.Bool
+
+	requests *metrics.Meter // Forwarded requests
+	failures *metrics.Meter // Forwarded requests failing for other reasons than the tx being rejected
+	latency  *metrics.Timer // Round trip time of the forwarded requests
+	health   *metrics.Gauge // 1 if the endpoint passed its last health check, 0 otherwise
+}
+
+// setHealthy updates the health of the endpoint, logging any change.
+func (ep *sequencerEndpoint) setHealthy(healthy bool, err error) {
+	if ep.healthy.Swap(healthy) != healthy {
+		if healthy {
+			log.Info("Sequencer endpoint recovered", "url", ep.url)
+		} else {
+			log.Warn("Sequencer endpoint unhealthy", "url", ep.url, "err", err)
+		}
+	}
+	if healthy {
+		ep.health.Update(1)
+	} else {
+		ep.health.Update(0)
+	}
+}
+
+// sequencerClient forwards transactions to a list of sequencer endpoints. The
+// endpoints are tried in the configured order, skipping the ones that failed
+// their last health check, and a forward that fails before reaching the
+// sequencer is retried on the next endpoint.
+type sequencerClient struct {
+	endpoints []*sequencerEndpoint
+	retries   int           // Number of times a failed forward is retried
+	interval  time.Duration // Interval between health checks, zero disables them
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newSequencerClient dials the comma separated list of sequencer endpoints.
+func newSequencerClient(urls string, retries int, interval time.Duration) (*sequencerClient, error) {
+	c := &sequencerClient{
+		retries:  retries,
+		interval: interval,
+		quit:     make(chan struct{}),
+	}
+	for i, url := range strings.Split(urls, ",") {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		client, err := rpc.DialContext(ctx, url)
+		cancel()
+		if err != nil {
+			c.close()
+			return nil, fmt.Errorf("failed to dial sequencer %s: %w", url, err)
+		}
+		prefix := fmt.Sprintf("eth/sequencer/%d/", i)
+		ep := &sequencerEndpoint{
+			url:      url,
+			client:   client,
+			requests: metrics.GetOrRegisterMeter(prefix+"requests", nil),
+			failures: metrics.GetOrRegisterMeter(prefix+"failures", nil),
+			latency:  metrics.GetOrRegisterTimer(prefix+"latency", nil),
+			health:   metrics.GetOrRegisterGauge(prefix+"healthy", nil),
+		}
+		ep.setHealthy(true, nil)
+		c.endpoints = append(c.endpoints, ep)
+		log.Info("Forwarding transactions to sequencer", "index", i, "url", url)
+	}
+	if len(c.endpoints) == 0 {
+		return nil, errors.New("no sequencer endpoints configured")
+	}
+	return c, nil
+}
+
+// start launches the health checks of the endpoints.
+func (c *sequencerClient) start() {
+	if c.interval == 0 {
+		return
+	}
+	c.wg.Add(1)
+	go c.healthLoop()
+}
+
+// close stops the health checks and closes the connections to the endpoints.
+func (c *sequencerClient) close() {
+	close(c.quit)
+	c.wg.Wait()
+	for _, ep := range c.endpoints {
+		ep.client.Close()
+	}
+}
+
+func (c *sequencerClient) healthLoop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, ep := range c.endpoints {
+				ctx, cancel := context.WithTimeout(context.Background(), min(c.interval, sequencerHealthTimeout))
+				var chainID hexutil.Big
+				err := ep.client.CallContext(ctx, &chainID, "eth_chainId")
+				cancel()
+				ep.setHealthy(err == nil, err)
+			}
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// order returns the endpoints in the order they are tried in: the healthy
+// ones first, then the unhealthy ones as a last resort.
+func (c *sequencerClient) order() []*sequencerEndpoint {
+	eps := make([]*sequencerEndpoint, 0, len(c.endpoints))
+	for _, ep := range c.endpoints {
+		if ep.healthy.Load() {
+			eps = append(eps, ep)
+		}
+	}
+	for _, ep := range c.endpoints {
+		if !ep.healthy.Load() {
+			eps = append(eps, ep)
+		}
+	}
+	return eps
+}
+
+// forward calls the given method on the sequencer, failing over to the next
+// endpoint and retrying if the call fails before the sequencer could process
+// it. Transactions are identified by their hash, so a retry rejected because
+// an earlier attempt did reach the sequencer returns errAlreadyForwarded.
+func (c *sequencerClient) forward(ctx context.Context, hash common.Hash, result any, method string, args ...any) error {
+	var (
+		eps   = c.order()
+		delay = sequencerRetryDelay
+		err   error
+	)
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(delay):
+				delay *= 2
+			case <-ctx.Done():
+				return err
+			}
+		}
+		ep := eps[attempt%len(eps)]
+
+		start := time.Now()
+		err = ep.client.CallContext(ctx, result, method, args...)
+		ep.requests.Mark(1)
+		ep.latency.UpdateSince(start)
+
+		if err == nil {
+			ep.setHealthy(true, nil)
+			return nil
+		}
+		if !retriableSequencerError(err) || ctx.Err() != nil {
+			if attempt > 0 && strings.Contains(err.Error(), txpool.ErrAlreadyKnown.Error()) {
+				return errAlreadyForwarded
+			}
+			return err
+		}
+		ep.failures.Mark(1)
+		ep.setHealthy(false, err)
+		log.Debug("Failed to forward to sequencer", "url", ep.url, "method", method, "tx", hash, "attempt", attempt, "err", err)
+	}
+	return err
+}
+
+// sendRawTransaction forwards a transaction to the sequencer.
+func (c *sequencerClient) sendRawTransaction(ctx context.Context, hash common.Hash, data []byte) error {
+	err := c.forward(ctx, hash, nil, "eth_sendRawTransaction", hexutil.Encode(data))
+	if errors.Is(err, errAlreadyForwarded) {
+		return nil
+	}
+	return err
+}
+
+// sendRawTransactionWithPreconf forwards a transaction to the sequencer, and
+// waits for its preconfirmation. If the preconfirmation of a retried forward is
+// lost, the transaction is reported as waiting.
+func (c *sequencerClient) sendRawTransactionWithPreconf(ctx context.Context, hash common.Hash, data []byte) (*core.NewPreconfTxEvent, error) {
+	var result *core.NewPreconfTxEvent
+	err := c.forward(ctx, hash, &result, "eth_sendRawTransactionWithPreconf", hexutil.Encode(data))
+	if errors.Is(err, errAlreadyForwarded) {
+		return &core.NewPreconfTxEvent{TxHash: hash, Status: core.PreconfStatusWaiting, Reason: err.Error()}, nil
+	}
+	return result, err
+}
+
+// retriableSequencerError reports whether a forward failed before the sequencer
+// could process it, i.e. the request may be safely sent again. Errors returned
+// by the sequencer itself are final.
+func retriableSequencerError(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError || httpErr.StatusCode == http.StatusTooManyRequests
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// testSequencer is a fake sequencer accepting every transaction once.
+type testSequencer struct {
+	known    map[common.Hash]bool
+	requests atomic.Int32
+	reject   error
+}
+
+func (s *testSequencer) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(common.Big1)
+}
+
+func (s *testSequencer) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	s.requests.Add(1)
+	if s.reject != nil {
+		return common.Hash{}, s.reject
+	}
+	hash := crypto.Keccak256Hash(input)
+	if s.known[hash] {
+		return common.Hash{}, txpool.ErrAlreadyKnown
+	}
+	s.known[hash] = true
+	return hash, nil
+}
+
+func (s *testSequencer) SendRawTransactionWithPreconf(input hexutil.Bytes) (*core.NewPreconfTxEvent, error) {
+	hash, err := s.SendRawTransaction(input)
+	if err != nil {
+		return nil, err
+	}
+	return &core.NewPreconfTxEvent{TxHash: hash, Status: core.PreconfStatusSuccess, PredictedL2BlockNumber: 7}, nil
+}
+
+func newTestSequencer(t *testing.T, seq *testSequencer) *httptest.Server {
+	seq.known = make(map[common.Hash]bool)
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", seq); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(server)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestSequencerFailover(t *testing.T) {
+	var (
+		down    = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusBadGateway) }))
+		backup  = new(testSequencer)
+		srv     = newTestSequencer(t, backup)
+		data    = []byte{0x01}
+		hash    = crypto.Keccak256Hash(data)
+		ctx     = context.Background()
+		client  = mustSequencerClient(t, down.URL+", "+srv.URL, 1)
+		primary = client.endpoints[0]
+	)
+	defer down.Close()
+
+	if err := client.sendRawTransaction(ctx, hash, data); err != nil {
+		t.Fatalf("failed to forward transaction: %v", err)
+	}
+	if backup.requests.Load() != 1 {
+		t.Fatalf("have %d requests on backup, want 1", backup.requests.Load())
+	}
+	if primary.healthy.Load() {
+		t.Fatal("failing endpoint still healthy")
+	}
+	// The unhealthy endpoint is only tried after the healthy ones.
+	if eps := client.order(); eps[0] != client.endpoints[1] {
+		t.Fatal("unhealthy endpoint tried first")
+	}
+	// Transactions rejected by the sequencer are not retried.
+	if err := client.sendRawTransaction(ctx, hash, data); err == nil || err.Error() != txpool.ErrAlreadyKnown.Error() {
+		t.Fatalf("have error %v, want %v", err, txpool.ErrAlreadyKnown)
+	}
+	if backup.requests.Load() != 2 {
+		t.Fatalf("have %d requests on backup, want 2", backup.requests.Load())
+	}
+}
+
+func TestSequencerRetryIdempotent(t *testing.T) {
+	var (
+		seq   = new(testSequencer)
+		srv   = newTestSequencer(t, seq)
+		data  = []byte{0x02}
+		hash  = crypto.Keccak256Hash(data)
+		calls atomic.Int32
+	)
+	// The first attempt reaches the sequencer, but its response gets lost.
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			srv.Config.Handler.ServeHTTP(httptest.NewRecorder(), r)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer flaky.Close()
+
+	client := mustSequencerClient(t, flaky.URL, 2)
+	if err := client.forward(context.Background(), hash, nil, "eth_sendRawTransaction", hexutil.Encode(data)); !errors.Is(err, errAlreadyForwarded) {
+		t.Fatalf("have error %v, want %v", err, errAlreadyForwarded)
+	}
+	if err := client.sendRawTransaction(context.Background(), hash, data); err == nil {
+		t.Fatal("resubmission of a known transaction must be rejected")
+	}
+}
+
+func TestSequencerPreconf(t *testing.T) {
+	var (
+		seq    = new(testSequencer)
+		srv    = newTestSequencer(t, seq)
+		data   = []byte{0x03}
+		hash   = crypto.Keccak256Hash(data)
+		client = mustSequencerClient(t, srv.URL, 0)
+	)
+	result, err := client.sendRawTransactionWithPreconf(context.Background(), hash, data)
+	if err != nil {
+		t.Fatalf("failed to forward preconf transaction: %v", err)
+	}
+	if result.TxHash != hash || result.Status != core.PreconfStatusSuccess || result.PredictedL2BlockNumber != 7 {
+		t.Fatalf("unexpected preconf result: %+v", result)
+	}
+	seq.reject = errors.New("preconf checker is not ready")
+	if _, err := client.sendRawTransactionWithPreconf(context.Background(), hash, data); err == nil {
+		t.Fatal("expected rejection to be returned")
+	}
+}
+
+func mustSequencerClient(t *testing.T, urls string, retries int) *sequencerClient {
+	client, err := newSequencerClient(urls, retries, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.close)
+	return client
+}
@@ -91,7 +91,6 @@ type Backend interface {
 	ChainDb() ethdb.Database
 	StateAtBlock(ctx context.Context, block *types.Block, reexec uint64, base *state.StateDB, readOnly bool, preferDisk bool) (*state.StateDB, StateReleaseFunc, error)
 	StateAtTransaction(ctx context.Context, block *types.Block, txIndex int, reexec uint64) (*types.Transaction, vm.BlockContext, *state.StateDB, StateReleaseFunc, error)
-	HistoricalRPCService() *rpc.Client
 }
 
 // API is the collection of tracing APIs exposed over the private debugging endpoint.
@@ -453,19 +452,6 @@ func (api *API) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber,
 		return nil, err
 	}
 
-	if api.backend.ChainConfig().IsOptimismPreBedrock(block.Number()) {
-		if api.backend.HistoricalRPCService() != nil {
-			var histResult []*txTraceResult
-			err = api.backend.HistoricalRPCService().CallContext(ctx, &histResult, "debug_traceBlockByNumber", number, config)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return histResult, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	return api.traceBlock(ctx, block, config)
 }
 
@@ -477,19 +463,6 @@ func (api *API) TraceBlockByHash(ctx context.Context, hash common.Hash, config *
 		return nil, err
 	}
 
-	if api.backend.ChainConfig().IsOptimismPreBedrock(block.Number()) {
-		if api.backend.HistoricalRPCService() != nil {
-			var histResult []*txTraceResult
-			err = api.backend.HistoricalRPCService().CallContext(ctx, &histResult, "debug_traceBlockByHash", hash, config)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return histResult, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	return api.traceBlock(ctx, block, config)
 }
 
@@ -910,19 +883,7 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 		// Only mined txes are supported
 		return nil, errTxNotFound
 	}
-
-	if api.backend.ChainConfig().IsOptimismPreBedrock(new(big.Int).SetUint64(blockNumber)) {
-		if api.backend.HistoricalRPCService() != nil {
-			var histResult json.RawMessage
-			err := api.backend.HistoricalRPCService().CallContext(ctx, &histResult, "debug_traceTransaction", hash, config)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return histResult, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
+	ethapi.MarkFinalizedResponse(ctx, api.backend, blockNumber)
 
 	// It shouldn't happen in practice.
 	if blockNumber == 0 {
@@ -936,7 +897,13 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 	if err != nil {
 		return nil, err
 	}
-	tx, vmctx, statedb, release, err := api.backend.StateAtTransaction(ctx, block, int(index), reexec)
+	return api.traceTxInBlock(ctx, block, int(index), reexec, config)
+}
+
+// traceTxInBlock traces the transaction at the given index of the block on top
+// of the state regenerated from the preceding transactions.
+func (api *API) traceTxInBlock(ctx context.Context, block *types.Block, index int, reexec uint64, config *TraceConfig) (interface{}, error) {
+	tx, vmctx, statedb, release, err := api.backend.StateAtTransaction(ctx, block, index, reexec)
 	if err != nil {
 		return nil, err
 	}
@@ -948,10 +915,10 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 		return nil, err
 	}
 	txctx := &Context{
-		BlockHash:   blockHash,
+		BlockHash:   block.Hash(),
 		BlockNumber: block.Number(),
-		TxIndex:     int(index),
-		TxHash:      hash,
+		TxIndex:     index,
+		TxHash:      tx.Hash(),
 	}
 	return api.traceTx(ctx, tx, msg, txctx, vmctx, statedb, config, nil)
 }
@@ -967,34 +934,15 @@ func (api *API) TraceCall(ctx context.Context, args ethapi.TransactionArgs, bloc
 	// Try to retrieve the specified block
 	var (
 		err         error
-		block       *types.Block
 		statedb     *state.StateDB
 		release     StateReleaseFunc
 		precompiles vm.PrecompiledContracts
 	)
-	if hash, ok := blockNrOrHash.Hash(); ok {
-		block, err = api.blockByHash(ctx, hash)
-	} else if number, ok := blockNrOrHash.Number(); ok {
-		if number == rpc.PendingBlockNumber {
-			// We don't have access to the miner here. For tracing 'future' transactions,
-			// it can be done with block- and state-overrides instead, which offers
-			// more flexibility and stability than trying to trace on 'pending', since
-			// the contents of 'pending' is unstable and probably not a true representation
-			// of what the next actual block is likely to contain.
-			return nil, errors.New("tracing on top of pending is not supported")
-		}
-		block, err = api.blockByNumber(ctx, number)
-	} else {
-		return nil, errors.New("invalid arguments; neither block nor hash specified")
-	}
+	block, err := api.callBlock(ctx, blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
 
-	if api.backend.ChainConfig().IsOptimismPreBedrock(block.Number()) {
-		return nil, errors.New("l2geth does not have a debug_traceCall method")
-	}
-
 	// try to recompute the state
 	reexec := defaultTraceReexec
 	if config != nil && config.Reexec != nil {
@@ -1060,6 +1008,149 @@ func (api *API) TraceCall(ctx context.Context, args ethapi.TransactionArgs, bloc
 	return api.traceTx(ctx, tx, msg, new(Context), blockContext, statedb, traceConfig, precompiles)
 }
 
+// Bundle is a batch of calls executed on top of the same block context by
+// TraceCallMany.
+type Bundle struct {
+	Transactions  []ethapi.TransactionArgs `json:"transactions"`
+	BlockOverride *override.BlockOverrides `json:"blockOverride"`
+}
+
+// TraceCallMany lets you trace a sequence of eth_calls grouped in bundles. The
+// calls are executed one after the other on top of the provided block, each
+// of them seeing the state changes made by the previous ones. The block
+// overrides of a bundle apply to all of its calls, on top of the ones in the
+// config. The state overrides are applied once, before the first call.
+//
+// The result contains one trace per call, grouped the same way as the bundles.
+func (api *API) TraceCallMany(ctx context.Context, bundles []*Bundle, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) ([][]interface{}, error) {
+	if len(bundles) == 0 {
+		return nil, errors.New("empty bundles")
+	}
+	block, err := api.callBlock(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	// try to recompute the state
+	reexec := defaultTraceReexec
+	if config != nil && config.Reexec != nil {
+		reexec = *config.Reexec
+	}
+	var (
+		statedb *state.StateDB
+		release StateReleaseFunc
+	)
+	if config != nil && config.TxIndex != nil {
+		_, _, statedb, release, err = api.backend.StateAtTransaction(ctx, block, int(*config.TxIndex), reexec)
+	} else {
+		statedb, release, err = api.backend.StateAtBlock(ctx, block, reexec, nil, true, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var (
+		traceConfig    *TraceConfig
+		blockOverrides *override.BlockOverrides
+		stateOverrides *override.StateOverride
+		results        = make([][]interface{}, 0, len(bundles))
+		txIndex        int
+	)
+	if config != nil {
+		traceConfig = &config.TraceConfig
+		blockOverrides = config.BlockOverrides
+		stateOverrides = config.StateOverrides
+	}
+	for i, bundle := range bundles {
+		if bundle == nil {
+			return nil, fmt.Errorf("bundle %d is empty", i)
+		}
+		h := block.Header()
+		blockContext := core.NewEVMBlockContext(h, api.chainContext(ctx), nil, api.backend.ChainConfig(), statedb)
+
+		// Simulating the bundles in the next block should resolve the
+		// hash of the base block, same as in TraceCall.
+		var number *hexutil.Big
+		for _, o := range []*override.BlockOverrides{blockOverrides, bundle.BlockOverride} {
+			if o != nil && o.Number != nil {
+				number = o.Number
+			}
+		}
+		if number != nil && number.ToInt().Uint64() == h.Number.Uint64()+1 {
+			h.ParentHash = h.Hash()
+			h.Number.Add(h.Number, big.NewInt(1))
+		}
+		if err := blockOverrides.Apply(&blockContext); err != nil {
+			return nil, err
+		}
+		if err := bundle.BlockOverride.Apply(&blockContext); err != nil {
+			return nil, fmt.Errorf("bundle %d: %w", i, err)
+		}
+		blockOverrides.ApplyL1Fees(statedb)
+		bundle.BlockOverride.ApplyL1Fees(statedb)
+
+		rules := api.backend.ChainConfig().Rules(blockContext.BlockNumber, blockContext.Random != nil, blockContext.Time)
+		precompiles := vm.ActivePrecompiledContracts(rules)
+		if i == 0 {
+			if err := stateOverrides.Apply(statedb, precompiles); err != nil {
+				return nil, err
+			}
+		}
+		traces := make([]interface{}, 0, len(bundle.Transactions))
+		for j, args := range bundle.Transactions {
+			if err := args.CallDefaults(api.backend.RPCGasCap(), blockContext.BaseFee, api.backend.ChainConfig().ChainID); err != nil {
+				return nil, fmt.Errorf("bundle %d, call %d: %w", i, j, err)
+			}
+			var (
+				msg   = args.ToMessage(blockContext.BaseFee, true, core.EthcallMode, args.GasPrice)
+				tx    = args.ToTransaction(types.LegacyTxType)
+				vmctx = blockContext
+			)
+			// Lower the basefee to 0 to avoid breaking EVM
+			// invariants (basefee < feecap).
+			if msg.GasPrice.Sign() == 0 {
+				vmctx.BaseFee = new(big.Int)
+			}
+			if msg.BlobGasFeeCap != nil && msg.BlobGasFeeCap.BitLen() == 0 {
+				vmctx.BlobBaseFee = new(big.Int)
+			}
+			txctx := &Context{
+				BlockNumber: vmctx.BlockNumber,
+				TxIndex:     txIndex,
+				TxHash:      tx.Hash(),
+			}
+			res, err := api.traceTx(ctx, tx, msg, txctx, vmctx, statedb, traceConfig, precompiles)
+			if err != nil {
+				return nil, fmt.Errorf("bundle %d, call %d: %w", i, j, err)
+			}
+			traces = append(traces, res)
+			txIndex++
+		}
+		results = append(results, traces)
+	}
+	return results, nil
+}
+
+// callBlock retrieves the block to execute the traced calls on top of.
+func (api *API) callBlock(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.Block, error) {
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		return api.blockByHash(ctx, hash)
+	}
+	number, ok := blockNrOrHash.Number()
+	if !ok {
+		return nil, errors.New("invalid arguments; neither block nor hash specified")
+	}
+	if number == rpc.PendingBlockNumber {
+		// We don't have access to the miner here. For tracing 'future' transactions,
+		// it can be done with block- and state-overrides instead, which offers
+		// more flexibility and stability than trying to trace on 'pending', since
+		// the contents of 'pending' is unstable and probably not a true representation
+		// of what the next actual block is likely to contain.
+		return nil, errors.New("tracing on top of pending is not supported")
+	}
+	return api.blockByNumber(ctx, number)
+}
+
 // traceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -1120,12 +1211,18 @@ func (api *API) traceTx(ctx context.Context, tx *types.Transaction, message *cor
 }
 
 // APIs return the collection of RPC services the tracer package offers.
-func APIs(backend Backend) []rpc.API {
+func APIs(backend Backend, indexer *TraceIndexer) []rpc.API {
+	api := NewAPI(backend)
+
 	// Append all the local APIs and return
 	return []rpc.API{
 		{
 			Namespace: "debug",
-			Service:   NewAPI(backend),
+			Service:   api,
+		},
+		{
+			Namespace: "trace",
+			Service:   NewTraceAPI(api, indexer),
 		},
 	}
 }
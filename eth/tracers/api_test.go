@@ -28,11 +28,11 @@ import (
 	"os"
 	"reflect"
 	"slices"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
-	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
@@ -65,8 +65,6 @@ type mockHistoricalBackend struct {
 	mock.Mock
 }
 
-// mockHistoricalBackend does not have a TraceCall, because pre-bedrock there is no debug_traceCall available
-
 func (m *mockHistoricalBackend) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *TraceConfig) ([]*txTraceResult, error) {
 	ret := m.Mock.MethodCalled("TraceBlockByNumber", number, config)
 	return ret[0].([]*txTraceResult), *ret[1].(*error)
@@ -86,6 +84,16 @@ func (m *mockHistoricalBackend) ExpectTraceTransaction(hash common.Hash, config
 	m.Mock.On("TraceTransaction", hash, config).Once().Return(json.RawMessage(jsonOut), &err)
 }
 
+func (m *mockHistoricalBackend) TraceCall(ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) (interface{}, error) {
+	ret := m.Mock.MethodCalled("TraceCall", blockNrOrHash, config)
+	return ret[0], *ret[1].(*error)
+}
+
+func (m *mockHistoricalBackend) ExpectTraceCall(blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig, out interface{}, err error) {
+	jsonOut, _ := json.Marshal(out)
+	m.Mock.On("TraceCall", blockNrOrHash, config).Once().Return(json.RawMessage(jsonOut), &err)
+}
+
 func newMockHistoricalBackend(t *testing.T, backend *mockHistoricalBackend) string {
 	s := rpc.NewServer()
 	err := node.RegisterApis([]rpc.API{
@@ -130,7 +138,7 @@ type testBackend struct {
 	refHook func() // Hook is invoked when the requested state is referenced
 	relHook func() // Hook is invoked when the requested state is released
 
-	historical     *rpc.Client
+	historical     *ethapi.HistoricalRPC
 	mockHistorical *mockHistoricalBackend
 }
 
@@ -149,7 +157,7 @@ func newTestBackend(t *testing.T, n int, gspec *core.Genesis, generator func(i i
 		chainConfig:    gspec.Config,
 		engine:         ethash.NewFaker(),
 		chaindb:        rawdb.NewMemoryDatabase(),
-		historical:     historicalClient,
+		historical:     ethapi.NewHistoricalRPC(historicalClient, ethapi.HistoricalRPCConfig{}),
 		mockHistorical: mock,
 	}
 	// Generate blocks for testing
@@ -174,6 +182,21 @@ func newTestBackend(t *testing.T, n int, gspec *core.Genesis, generator func(i i
 	return backend
 }
 
+// newHistoricalTestClient serves the tracing API of the backend, forwarding the
+// calls on pre-Bedrock blocks to its historical backend.
+func newHistoricalTestClient(t *testing.T, backend *testBackend) *rpc.Client {
+	server := rpc.NewServer()
+	if err := server.RegisterName("debug", NewAPI(backend)); err != nil {
+		t.Fatal(err)
+	}
+	server.SetFallback(backend.historical.Fallback)
+	t.Cleanup(server.Stop)
+
+	client := rpc.DialInProc(server)
+	t.Cleanup(client.Close)
+	return client
+}
+
 func (b *testBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
 	return b.chain.GetHeaderByHash(hash), nil
 }
@@ -231,6 +254,9 @@ func (b *testBackend) teardown() {
 }
 
 func (b *testBackend) StateAtBlock(ctx context.Context, block *types.Block, reexec uint64, base *state.StateDB, readOnly bool, preferDisk bool) (*state.StateDB, StateReleaseFunc, error) {
+	if b.chainConfig.IsOptimismPreBedrock(block.Number()) {
+		return nil, nil, rpc.ErrNoHistoricalFallback
+	}
 	statedb, err := b.chain.StateAt(block.Root())
 	if err != nil {
 		return nil, nil, errStateNotFound
@@ -253,7 +279,7 @@ func (b *testBackend) StateAtTransaction(ctx context.Context, block *types.Block
 	}
 	statedb, release, err := b.StateAtBlock(ctx, parent, reexec, nil, true, false)
 	if err != nil {
-		return nil, vm.BlockContext{}, nil, nil, errStateNotFound
+		return nil, vm.BlockContext{}, nil, nil, err
 	}
 	if txIndex == 0 && len(block.Transactions()) == 0 {
 		return nil, vm.BlockContext{}, statedb, release, nil
@@ -275,10 +301,6 @@ func (b *testBackend) StateAtTransaction(ctx context.Context, block *types.Block
 	return nil, vm.BlockContext{}, nil, nil, fmt.Errorf("transaction index %d out of range for block %#x", txIndex, block.Hash())
 }
 
-func (b *testBackend) HistoricalRPCService() *rpc.Client {
-	return b.historical
-}
-
 type stateTracer struct {
 	Balance map[common.Address]*hexutil.Big
 	Nonce   map[common.Address]hexutil.Uint64
@@ -585,6 +607,82 @@ func TestTraceCall(t *testing.T) {
 	}
 }
 
+func TestTraceCallMany(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(3)
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: types.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	backend := newTestBackend(t, 1, genesis, func(i int, b *core.BlockGen) {})
+	defer backend.teardown()
+	api := NewAPI(backend)
+
+	// tenths returns the given amount of tenths of an ether
+	tenths := func(n int64) *hexutil.Big {
+		return (*hexutil.Big)(new(big.Int).Mul(big.NewInt(n), big.NewInt(params.Ether/10)))
+	}
+	var (
+		sender   = accounts[1].addr // funded by the state override
+		receiver = accounts[2].addr // funded by the first bundle
+		latest   = rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		config   = &TraceCallConfig{
+			StateOverrides: &override.StateOverride{
+				sender: override.OverrideAccount{Balance: tenths(10)},
+			},
+		}
+	)
+	bundles := []*Bundle{
+		{
+			Transactions: []ethapi.TransactionArgs{
+				{From: &sender, To: &receiver, Value: tenths(5)},
+			},
+		},
+		{
+			Transactions: []ethapi.TransactionArgs{
+				{From: &receiver, To: &accounts[0].addr, Value: tenths(4)},
+				{From: &receiver, Input: &hexutil.Bytes{0x43}}, // blocknumber
+			},
+			BlockOverride: &override.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(0x1337))},
+		},
+	}
+	results, err := api.TraceCallMany(context.Background(), bundles, latest, config)
+	if err != nil {
+		t.Fatalf("failed to trace bundles: %v", err)
+	}
+	if len(results) != 2 || len(results[0]) != 1 || len(results[1]) != 2 {
+		t.Fatalf("unexpected number of traces: %v", results)
+	}
+	for i, traces := range results {
+		for j, trace := range traces {
+			var have *logger.ExecutionResult
+			if err := json.Unmarshal(trace.(json.RawMessage), &have); err != nil {
+				t.Fatalf("bundle %d, call %d: failed to unmarshal result %v", i, j, err)
+			}
+			if have.Failed {
+				t.Fatalf("bundle %d, call %d: unexpected failure", i, j)
+			}
+		}
+	}
+	// The block override of the bundle should be visible to its calls
+	var have *logger.ExecutionResult
+	if err := json.Unmarshal(results[1][1].(json.RawMessage), &have); err != nil {
+		t.Fatal(err)
+	}
+	if len(have.StructLogs) != 2 || !strings.Contains(string(have.StructLogs[1]), `"stack":["0x1337"]`) {
+		t.Fatalf("unexpected block number in trace: %s", results[1][1])
+	}
+	// Spending more than transferred by the previous bundles should fail
+	bundles[1].Transactions = append(bundles[1].Transactions, ethapi.TransactionArgs{From: &receiver, To: &accounts[0].addr, Value: tenths(2)})
+	_, err = api.TraceCallMany(context.Background(), bundles, latest, config)
+	if err == nil || !strings.Contains(err.Error(), "bundle 1, call 2: tracing failed: insufficient funds") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestTraceTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -674,15 +772,10 @@ func TestTraceTransactionHistorical(t *testing.T) {
 			StructLogs:  []json.RawMessage{},
 		},
 		nil)
-	api := NewAPI(backend)
-	result, err := api.TraceTransaction(context.Background(), target, nil)
-	if err != nil {
-		t.Errorf("Failed to trace transaction %v", err)
-	}
+	client := newHistoricalTestClient(t, backend)
 	var have *logger.ExecutionResult
-	spew.Dump(result)
-	if err := json.Unmarshal(result.(json.RawMessage), &have); err != nil {
-		t.Errorf("failed to unmarshal result %v", err)
+	if err := client.Call(&have, "debug_traceTransaction", target, nil); err != nil {
+		t.Errorf("Failed to trace transaction %v", err)
 	}
 	if !reflect.DeepEqual(have, &logger.ExecutionResult{
 		Gas:         params.TxGas,
@@ -808,9 +901,8 @@ func TestTraceBlockHistorical(t *testing.T) {
 	})
 	defer backend.mockHistorical.AssertExpectations(t)
 	defer backend.chain.Stop()
-	api := NewAPI(backend)
+	client := newHistoricalTestClient(t, backend)
 
-	var expectErr error
 	var config *TraceConfig
 	blockNumber := rpc.BlockNumber(3)
 	want := `[{"result":{"failed":false,"gas":21000,"returnValue":"","structLogs":[]}}]`
@@ -819,22 +911,47 @@ func TestTraceBlockHistorical(t *testing.T) {
 
 	backend.mockHistorical.ExpectTraceBlockByNumber(blockNumber, config, ret, nil)
 
-	result, err := api.TraceBlockByNumber(context.Background(), blockNumber, config)
-	if expectErr != nil {
-		if err == nil {
-			t.Errorf("want error %v", expectErr)
-		}
-		if !reflect.DeepEqual(err, expectErr) {
-			t.Errorf("error mismatch, want %v, get %v", expectErr, err)
-		}
-	}
-	if err != nil {
+	var result []*txTraceResult
+	if err := client.Call(&result, "debug_traceBlockByNumber", blockNumber, config); err != nil {
 		t.Errorf("want no error, have %v", err)
 	}
-	have, _ := json.Marshal(result)
-	if string(have) != want {
+	if !reflect.DeepEqual(result, ret) {
+		have, _ := json.Marshal(result)
 		t.Errorf("result mismatch, have\n%v\n, want\n%v\n", string(have), want)
 	}
+	// Blocks after Bedrock are traced locally.
+	if err := client.Call(&result, "debug_traceBlockByNumber", rpc.BlockNumber(genBlocks), config); err != nil {
+		t.Errorf("want no error, have %v", err)
+	}
+}
+
+func TestTraceCallHistorical(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{
+		Config: params.OptimismTestConfig,
+		Alloc: types.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	backend := newTestBackend(t, 3, genesis, func(i int, b *core.BlockGen) {})
+	defer backend.mockHistorical.AssertExpectations(t)
+	defer backend.chain.Stop()
+	client := newHistoricalTestClient(t, backend)
+
+	block := rpc.BlockNumberOrHashWithNumber(2)
+	want := logger.ExecutionResult{Gas: params.TxGas, ReturnValue: []byte{}, StructLogs: []json.RawMessage{}}
+	backend.mockHistorical.ExpectTraceCall(block, nil, want, nil)
+
+	args := ethapi.TransactionArgs{From: &accounts[0].addr, To: &accounts[1].addr}
+	var have *logger.ExecutionResult
+	if err := client.Call(&have, "debug_traceCall", args, block, nil); err != nil {
+		t.Fatalf("failed to trace call: %v", err)
+	}
+	if !reflect.DeepEqual(have, &want) {
+		t.Errorf("result mismatch, have %v, want %v", have, want)
+	}
 }
 
 func TestTracingWithOverrides(t *testing.T) {
@@ -0,0 +1,323 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	traceTypeTrace     = "trace"
+	traceTypeStateDiff = "stateDiff"
+	traceTypeVmTrace   = "vmTrace"
+)
+
+// replayTracerConfig is the mux tracer configuration used to replay the
+// transactions, combining the flat call tracer and the state diff tracer.
+var replayTracerConfig = json.RawMessage(`{"flatCallTracer":{"convertParityErrors":true},"stateDiffTracer":{}}`)
+
+const (
+	// maxTraceFilterBlocks is the maximum size of the block range searched by a
+	// single trace_filter call.
+	maxTraceFilterBlocks = 10000
+
+	// maxTraceFilterResults is the maximum number of call traces returned by a
+	// single trace_filter call, larger results must be paged with after and
+	// count. Every matched transaction is re-traced to serve the call.
+	maxTraceFilterResults = 1000
+)
+
+var errTraceIndexDisabled = errors.New("trace index is not enabled")
+
+// TraceAPI is the collection of parity compatible tracing APIs exposed over
+// the trace namespace.
+type TraceAPI struct {
+	api     *API
+	indexer *TraceIndexer // The call trace index serving trace_filter, nil if disabled
+}
+
+// NewTraceAPI creates a new API definition for the parity compatible tracing
+// methods of the Ethereum service.
+func NewTraceAPI(api *API, indexer *TraceIndexer) *TraceAPI {
+	return &TraceAPI{api: api, indexer: indexer}
+}
+
+// TraceResults is the parity compatible result of a transaction replay.
+type TraceResults struct {
+	Output          hexutil.Bytes   `json:"output"`
+	StateDiff       json.RawMessage `json:"stateDiff"`
+	Trace           json.RawMessage `json:"trace"`
+	VmTrace         json.RawMessage `json:"vmTrace"`
+	TransactionHash *common.Hash    `json:"transactionHash,omitempty"`
+}
+
+// ReplayTransaction replays the transaction with the given hash and returns
+// the requested traces of it. The supported trace types are "trace" and
+// "stateDiff".
+func (api *TraceAPI) ReplayTransaction(ctx context.Context, hash common.Hash, traceTypes []string) (*TraceResults, error) {
+	types, err := parseTraceTypes(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+	res, err := api.api.TraceTransaction(ctx, hash, replayTraceConfig())
+	if err != nil {
+		return nil, err
+	}
+	return newTraceResults(res, types)
+}
+
+// ReplayBlockTransactions replays all the transactions in the given block and
+// returns the requested traces of them. The supported trace types are "trace"
+// and "stateDiff".
+func (api *TraceAPI) ReplayBlockTransactions(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, traceTypes []string) ([]*TraceResults, error) {
+	types, err := parseTraceTypes(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+	var txs []*txTraceResult
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		txs, err = api.api.TraceBlockByHash(ctx, hash, replayTraceConfig())
+	} else if number, ok := blockNrOrHash.Number(); ok {
+		txs, err = api.api.TraceBlockByNumber(ctx, number, replayTraceConfig())
+	} else {
+		return nil, errors.New("invalid arguments; neither block nor hash specified")
+	}
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TraceResults, 0, len(txs))
+	for _, tx := range txs {
+		if tx.Error != "" {
+			return nil, fmt.Errorf("failed to replay transaction %#x: %s", tx.TxHash, tx.Error)
+		}
+		res, err := newTraceResults(tx.Result, types)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay transaction %#x: %w", tx.TxHash, err)
+		}
+		res.TransactionHash = &tx.TxHash
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// TraceFilterArgs represents the arguments of trace_filter.
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// Filter returns the call traces within the block range matching the given
+// sender and recipient addresses. The traces are answered from the call trace
+// index, only the matched transactions are re-traced. The index is not back
+// filled, it only covers the blocks imported since it was enabled.
+func (api *TraceAPI) Filter(ctx context.Context, args TraceFilterArgs) ([]json.RawMessage, error) {
+	if api.indexer == nil {
+		return nil, errTraceIndexDisabled
+	}
+	if len(args.FromAddress) == 0 && len(args.ToAddress) == 0 {
+		return nil, errors.New("fromAddress or toAddress must be specified")
+	}
+	tail, next, ok := api.indexer.indexedRange()
+	if !ok || tail == next {
+		return nil, errors.New("trace index is not yet available")
+	}
+	from, to := tail, next-1
+	if args.FromBlock != nil {
+		number, err := api.resolveBlockNumber(ctx, *args.FromBlock)
+		if err != nil {
+			return nil, err
+		}
+		from = number
+	}
+	if args.ToBlock != nil {
+		number, err := api.resolveBlockNumber(ctx, *args.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+		// The index might fall behind the chain head slightly, the
+		// block tags are served up to the last indexed block.
+		if *args.ToBlock >= 0 || number < to {
+			to = number
+		}
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if to-from >= maxTraceFilterBlocks {
+		return nil, fmt.Errorf("block range [%d, %d] exceeds the limit of %d blocks", from, to, maxTraceFilterBlocks)
+	}
+	if from < tail || to >= next {
+		return nil, fmt.Errorf("block range [%d, %d] is not indexed, available range [%d, %d]", from, to, tail, next-1)
+	}
+	matches, err := api.indexer.filter(from, to, args.FromAddress, args.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+	if args.After != nil {
+		matches = matches[min(*args.After, uint64(len(matches))):]
+	}
+	if args.Count != nil {
+		if *args.Count > maxTraceFilterResults {
+			return nil, fmt.Errorf("count %d exceeds the limit of %d traces", *args.Count, maxTraceFilterResults)
+		}
+		if *args.Count < uint64(len(matches)) {
+			matches = matches[:*args.Count]
+		}
+	}
+	if len(matches) > maxTraceFilterResults {
+		return nil, fmt.Errorf("%d traces matched, exceeding the limit of %d traces, use after and count to page the results", len(matches), maxTraceFilterResults)
+	}
+	// Re-trace the matched transactions, picking the matched call frames
+	results := make([]json.RawMessage, 0, len(matches))
+	for len(matches) > 0 {
+		n := 1
+		for n < len(matches) && matches[n].Number == matches[0].Number && matches[n].TxIndex == matches[0].TxIndex {
+			n++
+		}
+		frames, err := api.traceIndexedTx(ctx, matches[0].Number, int(matches[0].TxIndex))
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range matches[:n] {
+			i := slices.IndexFunc(frames, func(f *indexedFrame) bool { return slices.Equal(f.TraceAddress, m.Path) })
+			if i < 0 {
+				return nil, fmt.Errorf("trace %v of transaction %d in block %d not found", m.Path, m.TxIndex, m.Number)
+			}
+			results = append(results, frames[i].raw)
+		}
+		matches = matches[n:]
+	}
+	return results, nil
+}
+
+// traceIndexedTx re-traces the transaction at the given position with the
+// config used by the trace index.
+func (api *TraceAPI) traceIndexedTx(ctx context.Context, number uint64, index int) ([]*indexedFrame, error) {
+	block, err := api.api.blockByNumber(ctx, rpc.BlockNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	res, err := api.api.traceTxInBlock(ctx, block, index, defaultTraceReexec, traceIndexConfig())
+	if err != nil {
+		return nil, err
+	}
+	return decodeIndexedFrames(res)
+}
+
+// resolveBlockNumber resolves the block tags to the block number.
+func (api *TraceAPI) resolveBlockNumber(ctx context.Context, number rpc.BlockNumber) (uint64, error) {
+	if number >= 0 {
+		return uint64(number), nil
+	}
+	header, err := api.api.backend.HeaderByNumber(ctx, number)
+	if err != nil {
+		return 0, err
+	}
+	if header == nil {
+		return 0, fmt.Errorf("block %v not found", number)
+	}
+	return header.Number.Uint64(), nil
+}
+
+// replayTraceConfig returns the trace config for replaying transactions.
+func replayTraceConfig() *TraceConfig {
+	tracer := "muxTracer"
+	return &TraceConfig{Tracer: &tracer, TracerConfig: replayTracerConfig}
+}
+
+// parseTraceTypes validates the requested trace types.
+func parseTraceTypes(traceTypes []string) (map[string]bool, error) {
+	types := make(map[string]bool)
+	for _, typ := range traceTypes {
+		switch typ {
+		case traceTypeTrace, traceTypeStateDiff:
+			types[typ] = true
+		case traceTypeVmTrace:
+			return nil, errors.New("vmTrace is not supported")
+		default:
+			return nil, fmt.Errorf("unknown trace type %q", typ)
+		}
+	}
+	return types, nil
+}
+
+// newTraceResults converts the result of the mux tracer into the parity
+// compatible replay result, containing the requested trace types only.
+func newTraceResults(result interface{}, types map[string]bool) (*TraceResults, error) {
+	blob, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var mux struct {
+		Calls     []map[string]json.RawMessage `json:"flatCallTracer"`
+		StateDiff json.RawMessage              `json:"stateDiffTracer"`
+	}
+	if err := json.Unmarshal(blob, &mux); err != nil {
+		return nil, err
+	}
+	res := &TraceResults{
+		Output:    hexutil.Bytes{},
+		StateDiff: json.RawMessage("null"),
+		Trace:     json.RawMessage("[]"),
+		VmTrace:   json.RawMessage("null"),
+	}
+	if len(mux.Calls) > 0 {
+		if enc, ok := mux.Calls[0]["result"]; ok {
+			var top struct {
+				Output hexutil.Bytes `json:"output"`
+			}
+			if err := json.Unmarshal(enc, &top); err != nil {
+				return nil, err
+			}
+			if top.Output != nil {
+				res.Output = top.Output
+			}
+		}
+	}
+	if types[traceTypeTrace] {
+		// The replayed traces are not bound to the block context
+		for _, call := range mux.Calls {
+			delete(call, "blockHash")
+			delete(call, "blockNumber")
+			delete(call, "transactionHash")
+			delete(call, "transactionPosition")
+		}
+		calls := mux.Calls
+		if calls == nil {
+			calls = []map[string]json.RawMessage{}
+		}
+		if res.Trace, err = json.Marshal(calls); err != nil {
+			return nil, err
+		}
+	}
+	if types[traceTypeStateDiff] && len(mux.StateDiff) > 0 {
+		res.StateDiff = mux.StateDiff
+	}
+	return res, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewTraceResults(t *testing.T) {
+	result := json.RawMessage(`{
+		"flatCallTracer": [
+			{"action": {"callType": "call"}, "blockHash": "0x01", "blockNumber": 1, "result": {"gasUsed": "0x0", "output": "0xbeef"}, "subtraces": 0, "traceAddress": [], "transactionHash": "0x02", "transactionPosition": 0, "type": "call"}
+		],
+		"stateDiffTracer": {"0x0000000000000000000000000000000000000001": {"balance": "=", "code": "=", "nonce": {"*": {"from": "0x0", "to": "0x1"}}, "storage": {}}}
+	}`)
+	var tests = []struct {
+		types     []string
+		trace     string
+		stateDiff string
+		err       bool
+	}{
+		{
+			types:     nil,
+			trace:     `[]`,
+			stateDiff: `null`,
+		},
+		{
+			types:     []string{"trace"},
+			trace:     `[{"action":{"callType":"call"},"result":{"gasUsed":"0x0","output":"0xbeef"},"subtraces":0,"traceAddress":[],"type":"call"}]`,
+			stateDiff: `null`,
+		},
+		{
+			types:     []string{"stateDiff"},
+			trace:     `[]`,
+			stateDiff: `{"0x0000000000000000000000000000000000000001":{"balance":"=","code":"=","nonce":{"*":{"from":"0x0","to":"0x1"}},"storage":{}}}`,
+		},
+		{
+			types: []string{"vmTrace"},
+			err:   true,
+		},
+		{
+			types: []string{"unknown"},
+			err:   true,
+		},
+	}
+	for i, test := range tests {
+		types, err := parseTraceTypes(test.types)
+		if test.err {
+			if err == nil {
+				t.Fatalf("test %d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("test %d: unexpected error: %v", i, err)
+		}
+		res, err := newTraceResults(result, types)
+		if err != nil {
+			t.Fatalf("test %d: failed to convert result: %v", i, err)
+		}
+		if res.Output.String() != "0xbeef" {
+			t.Fatalf("test %d: unexpected output %s", i, res.Output)
+		}
+		if have := compactJSON(t, res.Trace); have != test.trace {
+			t.Fatalf("test %d: unexpected trace, want %s, have %s", i, test.trace, have)
+		}
+		if have := compactJSON(t, res.StateDiff); have != test.stateDiff {
+			t.Fatalf("test %d: unexpected state diff, want %s, have %s", i, test.stateDiff, have)
+		}
+	}
+}
+
+func compactJSON(t *testing.T, blob json.RawMessage) string {
+	var v any
+	if err := json.Unmarshal(blob, &v); err != nil {
+		t.Fatal(err)
+	}
+	enc, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(enc)
+}
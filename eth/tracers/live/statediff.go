@@ -0,0 +1,504 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package live
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+func init() {
+	tracers.LiveDirectory.Register("stateDiff", newStateDiffTracer)
+}
+
+const (
+	stateDiffRecordBlock  = "block"  // Record of the state changes of an imported block
+	stateDiffRecordRevert = "revert" // Record of a previously written block being reorged out
+
+	stateDiffFormatJSON = "jsonl"
+	stateDiffFormatRLP  = "rlp"
+
+	// stateDiffCheckpointName is the name of the checkpoint file in the
+	// output directory.
+	stateDiffCheckpointName = "statediff-checkpoint.json"
+
+	// stateDiffReorgDepth is the number of the recently written blocks kept
+	// in the checkpoint to detect reorgs against.
+	stateDiffReorgDepth = 128
+)
+
+// stateDiffRecord is a single record in the output files. Block records list
+// the post-block state of every account changed by the block, along with the
+// logs emitted in the block. Revert records carry the block info only.
+type stateDiffRecord struct {
+	Type       string              `json:"type"`
+	Number     uint64              `json:"number"`
+	Hash       common.Hash         `json:"hash"`
+	ParentHash common.Hash         `json:"parentHash"`
+	Accounts   []*stateDiffAccount `json:"accounts,omitempty"`
+	Logs       []*types.Log        `json:"logs,omitempty"`
+}
+
+// stateDiffAccount is the post-block state of a changed account. Deleted is
+// set if the account was removed during the block, in which case its storage
+// is wiped before the listed slots apply. Code is only set if it was changed.
+// Nonce is missing if no state was accessible in the block, which only
+// happens for balance changes outside of transactions.
+type stateDiffAccount struct {
+	Address common.Address  `json:"address"`
+	Deleted bool            `json:"deleted,omitempty"`
+	Balance *big.Int        `json:"balance"`
+	Nonce   *uint64         `json:"nonce,omitempty" rlp:"nil"`
+	Code    []byte          `json:"code,omitempty"`
+	Storage []stateDiffSlot `json:"storage,omitempty"`
+}
+
+//go:generate go run github.com/fjl/gencodec -type stateDiffAccount -field-override stateDiffAccountMarshaling -out gen_statediffaccount.go
+type stateDiffAccountMarshaling struct {
+	Balance *hexutil.Big
+	Nonce   *hexutil.Uint64
+	Code    hexutil.Bytes
+}
+
+type stateDiffSlot struct {
+	Key   common.Hash `json:"key"`
+	Value common.Hash `json:"value"`
+}
+
+// stateDiffBlockRef identifies a written block.
+type stateDiffBlockRef struct {
+	Number     uint64      `json:"number"`
+	Hash       common.Hash `json:"hash"`
+	ParentHash common.Hash `json:"parentHash"`
+}
+
+// stateDiffCheckpoint is persisted after every written record. Blocks holds
+// the recently written blocks which were not reverted, the last one of them
+// being the last block fully written to the output files.
+type stateDiffCheckpoint struct {
+	Blocks []stateDiffBlockRef `json:"blocks"`
+}
+
+// stateDiffAccountState tracks the changes of an account within a block.
+type stateDiffAccountState struct {
+	prevBalance *big.Int // Balance before the block, nil if not yet changed
+	prevNonce   *uint64  // Nonce before the block, nil if not yet changed
+	prevCode    []byte   // Code before the block, nil if not yet changed
+	prevStorage map[common.Hash]common.Hash
+
+	balance *big.Int
+	nonce   *uint64
+	code    []byte
+	storage map[common.Hash]common.Hash
+	deleted bool
+}
+
+type stateDiffTracer struct {
+	logger     *lumberjack.Logger
+	format     string
+	checkpoint string
+	recent     []stateDiffBlockRef // Recently written blocks, oldest first
+
+	block   *types.Block // Block being traced
+	skip    bool         // Whether the block is already written
+	statedb tracing.StateDB
+	dirty   map[common.Address]struct{} // Accounts changed since the last refresh
+	changes map[common.Address]*stateDiffAccountState
+	logs    []*types.Log
+}
+
+type stateDiffTracerConfig struct {
+	Path    string `json:"path"`    // Path to the directory where the diffs and the checkpoint will be stored
+	Format  string `json:"format"`  // Format of the records, either "jsonl" (default) or "rlp"
+	MaxSize int    `json:"maxSize"` // MaxSize is the maximum size in megabytes of the diff file before it gets rotated. It defaults to 100 megabytes.
+}
+
+func newStateDiffTracer(cfg json.RawMessage) (*tracing.Hooks, error) {
+	var config stateDiffTracerConfig
+	if err := json.Unmarshal(cfg, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %v", err)
+	}
+	if config.Path == "" {
+		return nil, errors.New("state diff tracer output path is required")
+	}
+	if config.Format == "" {
+		config.Format = stateDiffFormatJSON
+	}
+	if config.Format != stateDiffFormatJSON && config.Format != stateDiffFormatRLP {
+		return nil, fmt.Errorf("unknown state diff format %q", config.Format)
+	}
+	// Store diffs in a rotating file
+	logger := &lumberjack.Logger{
+		Filename: filepath.Join(config.Path, "statediff."+config.Format),
+	}
+	if config.MaxSize > 0 {
+		logger.MaxSize = config.MaxSize
+	}
+	t := &stateDiffTracer{
+		logger:     logger,
+		format:     config.Format,
+		checkpoint: filepath.Join(config.Path, stateDiffCheckpointName),
+	}
+	// Resume from the checkpoint of the previous run, if any
+	blob, err := os.ReadFile(t.checkpoint)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read state diff checkpoint: %v", err)
+	}
+	if err == nil {
+		var checkpoint stateDiffCheckpoint
+		if err := json.Unmarshal(blob, &checkpoint); err != nil {
+			return nil, fmt.Errorf("failed to parse state diff checkpoint: %v", err)
+		}
+		t.recent = checkpoint.Blocks
+	}
+	return &tracing.Hooks{
+		OnBlockStart:        t.onBlockStart,
+		OnBlockEnd:          t.onBlockEnd,
+		OnGenesisBlock:      t.onGenesisBlock,
+		OnTxStart:           t.onTxStart,
+		OnTxEnd:             t.onTxEnd,
+		OnSystemCallStartV2: t.onSystemCallStart,
+		OnSystemCallEnd:     t.onSystemCallEnd,
+		OnBalanceChange:     t.onBalanceChange,
+		OnNonceChange:       t.onNonceChange,
+		OnCodeChange:        t.onCodeChange,
+		OnStorageChange:     t.onStorageChange,
+		OnClose:             t.onClose,
+	}, nil
+}
+
+func (t *stateDiffTracer) onBlockStart(ev tracing.BlockEvent) {
+	t.block = ev.Block
+	t.statedb = nil
+	t.dirty = make(map[common.Address]struct{})
+	t.changes = make(map[common.Address]*stateDiffAccountState)
+	t.logs = nil
+
+	// Blocks might be re-executed after a restart, skip the ones written
+	// already.
+	t.skip = slices.ContainsFunc(t.recent, func(ref stateDiffBlockRef) bool {
+		return ref.Hash == ev.Block.Hash()
+	})
+}
+
+func (t *stateDiffTracer) onBlockEnd(err error) {
+	if err != nil || t.skip {
+		return
+	}
+	t.refresh()
+
+	record := &stateDiffRecord{
+		Type:       stateDiffRecordBlock,
+		Number:     t.block.NumberU64(),
+		Hash:       t.block.Hash(),
+		ParentHash: t.block.ParentHash(),
+		Logs:       t.logs,
+	}
+	for addr, state := range t.changes {
+		if account := state.diff(addr); account != nil {
+			record.Accounts = append(record.Accounts, account)
+		}
+	}
+	slices.SortFunc(record.Accounts, func(a, b *stateDiffAccount) int {
+		return a.Address.Cmp(b.Address)
+	})
+	t.revert(record.Number, record.ParentHash)
+	t.append(record)
+}
+
+func (t *stateDiffTracer) onGenesisBlock(b *types.Block, alloc types.GenesisAlloc) {
+	record := &stateDiffRecord{
+		Type:       stateDiffRecordBlock,
+		Number:     b.NumberU64(),
+		Hash:       b.Hash(),
+		ParentHash: b.ParentHash(),
+	}
+	for addr, account := range alloc {
+		nonce := account.Nonce
+		diff := &stateDiffAccount{
+			Address: addr,
+			Balance: new(big.Int),
+			Nonce:   &nonce,
+			Code:    account.Code,
+		}
+		if account.Balance != nil {
+			diff.Balance.Set(account.Balance)
+		}
+		for key, value := range account.Storage {
+			diff.Storage = append(diff.Storage, stateDiffSlot{Key: key, Value: value})
+		}
+		slices.SortFunc(diff.Storage, func(a, b stateDiffSlot) int {
+			return a.Key.Cmp(b.Key)
+		})
+		record.Accounts = append(record.Accounts, diff)
+	}
+	slices.SortFunc(record.Accounts, func(a, b *stateDiffAccount) int {
+		return a.Address.Cmp(b.Address)
+	})
+	t.revert(record.Number, record.ParentHash)
+	t.append(record)
+}
+
+func (t *stateDiffTracer) onTxStart(vm *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	t.statedb = vm.StateDB
+}
+
+func (t *stateDiffTracer) onTxEnd(receipt *types.Receipt, err error) {
+	if err != nil {
+		return
+	}
+	t.refresh()
+	t.logs = append(t.logs, receipt.Logs...)
+}
+
+func (t *stateDiffTracer) onSystemCallStart(vm *tracing.VMContext) {
+	t.statedb = vm.StateDB
+}
+
+func (t *stateDiffTracer) onSystemCallEnd() {
+	t.refresh()
+}
+
+func (t *stateDiffTracer) onBalanceChange(addr common.Address, prev, value *big.Int, reason tracing.BalanceChangeReason) {
+	state := t.account(addr)
+	if state.prevBalance == nil {
+		state.prevBalance = new(big.Int).Set(prev)
+	}
+	state.balance = new(big.Int).Set(value)
+}
+
+func (t *stateDiffTracer) onNonceChange(addr common.Address, prev, value uint64) {
+	state := t.account(addr)
+	if state.prevNonce == nil {
+		state.prevNonce = &prev
+	}
+	state.nonce = &value
+}
+
+func (t *stateDiffTracer) onCodeChange(addr common.Address, prevCodeHash common.Hash, prev []byte, codeHash common.Hash, code []byte) {
+	state := t.account(addr)
+	if state.prevCode == nil {
+		state.prevCode = common.CopyBytes(prev)
+		if state.prevCode == nil {
+			state.prevCode = []byte{}
+		}
+	}
+	state.code = common.CopyBytes(code)
+}
+
+func (t *stateDiffTracer) onStorageChange(addr common.Address, slot common.Hash, prev, value common.Hash) {
+	state := t.account(addr)
+	if _, ok := state.prevStorage[slot]; !ok {
+		state.prevStorage[slot] = prev
+	}
+	state.storage[slot] = value
+}
+
+func (t *stateDiffTracer) onClose() {
+	if err := t.logger.Close(); err != nil {
+		log.Warn("Failed to close state diff tracer file", "err", err)
+	}
+}
+
+// account returns the change tracker of the given account, marking it dirty.
+func (t *stateDiffTracer) account(addr common.Address) *stateDiffAccountState {
+	t.dirty[addr] = struct{}{}
+	state, ok := t.changes[addr]
+	if !ok {
+		state = &stateDiffAccountState{
+			prevStorage: make(map[common.Hash]common.Hash),
+			storage:     make(map[common.Hash]common.Hash),
+		}
+		t.changes[addr] = state
+	}
+	return state
+}
+
+// refresh reloads the dirty accounts from the state. The state hooks don't
+// report the changes undone by reverted calls, the finalised state of the
+// transaction is the source of truth.
+func (t *stateDiffTracer) refresh() {
+	if t.statedb == nil {
+		return
+	}
+	for addr := range t.dirty {
+		state := t.changes[addr]
+		if !t.statedb.Exist(addr) && state.existed() {
+			state.deleted = true
+		}
+		nonce := t.statedb.GetNonce(addr)
+		state.balance = t.statedb.GetBalance(addr).ToBig()
+		state.nonce = &nonce
+		if state.prevCode != nil {
+			state.code = t.statedb.GetCode(addr)
+		}
+		for slot := range state.storage {
+			state.storage[slot] = t.statedb.GetState(addr, slot)
+		}
+	}
+	clear(t.dirty)
+}
+
+// existed reports whether the account had any content before the block, as
+// far as it is known from the changes.
+func (s *stateDiffAccountState) existed() bool {
+	if s.prevBalance != nil && s.prevBalance.Sign() > 0 {
+		return true
+	}
+	if s.prevNonce != nil && *s.prevNonce > 0 {
+		return true
+	}
+	if len(s.prevCode) > 0 {
+		return true
+	}
+	for _, value := range s.prevStorage {
+		if value != (common.Hash{}) {
+			return true
+		}
+	}
+	return false
+}
+
+// diff returns the post-block state of the account, or nil if the account was
+// changed back to its original state.
+func (s *stateDiffAccountState) diff(addr common.Address) *stateDiffAccount {
+	changed := s.deleted
+	if s.prevBalance != nil && s.prevBalance.Cmp(s.balance) != 0 {
+		changed = true
+	}
+	if s.prevNonce != nil && *s.prevNonce != *s.nonce {
+		changed = true
+	}
+	account := &stateDiffAccount{
+		Address: addr,
+		Deleted: s.deleted,
+		Balance: s.balance,
+		Nonce:   s.nonce,
+	}
+	if s.prevCode != nil && !bytes.Equal(s.prevCode, s.code) {
+		account.Code = s.code
+		changed = true
+	}
+	for slot, value := range s.storage {
+		if s.deleted || s.prevStorage[slot] != value {
+			account.Storage = append(account.Storage, stateDiffSlot{Key: slot, Value: value})
+		}
+	}
+	if !changed && len(account.Storage) == 0 {
+		return nil
+	}
+	slices.SortFunc(account.Storage, func(a, b stateDiffSlot) int {
+		return a.Key.Cmp(b.Key)
+	})
+	return account
+}
+
+// revert emits revert records for the written blocks which are not ancestors
+// of the block with the given number and parent. The reverted blocks are the
+// ones at or above the given number, and on top of the parent if it's known.
+func (t *stateDiffTracer) revert(number uint64, parent common.Hash) {
+	keep := len(t.recent)
+	for keep > 0 && t.recent[keep-1].Number >= number {
+		keep--
+	}
+	if i := slices.IndexFunc(t.recent, func(ref stateDiffBlockRef) bool { return ref.Hash == parent }); i >= 0 {
+		keep = min(keep, i+1)
+	} else if keep > 0 && number > 0 && t.recent[keep-1].Number == number-1 {
+		log.Warn("State diff parent not found, reverting the previous block", "number", number, "parent", parent)
+		keep--
+	}
+	for i := len(t.recent) - 1; i >= keep; i-- {
+		ref := t.recent[i]
+		t.write(&stateDiffRecord{
+			Type:       stateDiffRecordRevert,
+			Number:     ref.Number,
+			Hash:       ref.Hash,
+			ParentHash: ref.ParentHash,
+		})
+	}
+	t.recent = t.recent[:keep]
+}
+
+// append writes the block record and advances the checkpoint, persisting the
+// preceding reverts as well.
+func (t *stateDiffTracer) append(record *stateDiffRecord) {
+	if t.write(record) {
+		t.recent = append(t.recent, stateDiffBlockRef{
+			Number:     record.Number,
+			Hash:       record.Hash,
+			ParentHash: record.ParentHash,
+		})
+	}
+	if len(t.recent) > stateDiffReorgDepth {
+		t.recent = slices.Clone(t.recent[len(t.recent)-stateDiffReorgDepth:])
+	}
+	if err := t.saveCheckpoint(); err != nil {
+		log.Warn("Failed to write state diff checkpoint", "err", err)
+	}
+}
+
+// write encodes the record in the configured format and writes it to the
+// output file in one go, so the rotation never splits a record.
+func (t *stateDiffTracer) write(record *stateDiffRecord) bool {
+	var (
+		out []byte
+		err error
+	)
+	if t.format == stateDiffFormatRLP {
+		out, err = rlp.EncodeToBytes(record)
+	} else {
+		out, err = json.Marshal(record)
+		out = append(out, '\n')
+	}
+	if err != nil {
+		log.Warn("Failed to encode state diff record", "number", record.Number, "err", err)
+		return false
+	}
+	if _, err := t.logger.Write(out); err != nil {
+		log.Warn("Failed to write to state diff tracer file", "err", err)
+		return false
+	}
+	return true
+}
+
+// saveCheckpoint atomically replaces the checkpoint file.
+func (t *stateDiffTracer) saveCheckpoint() error {
+	blob, err := json.Marshal(stateDiffCheckpoint{Blocks: t.recent})
+	if err != nil {
+		return err
+	}
+	tmp := t.checkpoint + ".tmp"
+	if err := os.WriteFile(tmp, blob, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, t.checkpoint)
+}
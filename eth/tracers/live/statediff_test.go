@@ -0,0 +1,201 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package live
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func readStateDiffs(t *testing.T, dir string, format string) []*stateDiffRecord {
+	t.Helper()
+
+	file, err := os.Open(filepath.Join(dir, "statediff."+format))
+	if err != nil {
+		t.Fatalf("failed to open output file: %v", err)
+	}
+	defer file.Close()
+
+	var records []*stateDiffRecord
+	if format == stateDiffFormatRLP {
+		stream := rlp.NewStream(file, 0)
+		for {
+			record := new(stateDiffRecord)
+			if err := stream.Decode(record); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				t.Fatalf("failed to decode record: %v", err)
+			}
+			records = append(records, record)
+		}
+		return records
+	}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := new(stateDiffRecord)
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			t.Fatalf("failed to unmarshal record: %v", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestStateDiffTracer(t *testing.T) {
+	for _, format := range []string{stateDiffFormatJSON, stateDiffFormatRLP} {
+		t.Run(format, func(t *testing.T) {
+			testStateDiffTracer(t, format)
+		})
+	}
+}
+
+func testStateDiffTracer(t *testing.T, format string) {
+	var (
+		key, _   = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		sender   = crypto.PubkeyToAddress(key.PublicKey)
+		receiver = common.HexToAddress("0xaaaa")
+		storer   = common.HexToAddress("0xbbbb") // SSTORE(0, 1)
+		reverter = common.HexToAddress("0xcccc") // SSTORE(0, 1), REVERT(0, 0)
+		engine   = ethash.NewFaker()
+		signer   = types.LatestSigner(params.TestChainConfig)
+		genesis  = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				sender:   {Balance: big.NewInt(params.Ether)},
+				storer:   {Balance: new(big.Int), Code: common.FromHex("0x600160005500")},
+				reverter: {Balance: new(big.Int), Code: common.FromHex("0x600160005560006000fd")},
+				// Mantle gas accounting requires the token ratio to be set
+				types.GasOracleAddr: {
+					Balance: new(big.Int),
+					Storage: map[common.Hash]common.Hash{types.TokenRatioSlot: common.BigToHash(common.Big1)},
+				},
+			},
+		}
+		dir = t.TempDir()
+	)
+	newTracer := func() *tracing.Hooks {
+		hooks, err := tracers.LiveDirectory.New("stateDiff", json.RawMessage(fmt.Sprintf(`{"path":%q,"format":%q}`, dir, format)))
+		if err != nil {
+			t.Fatalf("failed to create tracer: %v", err)
+		}
+		return hooks
+	}
+	options := core.DefaultConfig().WithStateScheme(rawdb.PathScheme)
+	options.VmConfig = vm.Config{Tracer: newTracer()}
+	chain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), genesis, engine, options)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	// Import two blocks, then reorg them out with a longer fork
+	_, blocks, _ := core.GenerateChainWithGenesis(genesis, engine, 2, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(common.Address{1})
+		to := []common.Address{storer, reverter}[i]
+		b.AddTx(types.MustSignNewTx(key, signer, &types.LegacyTx{Nonce: b.TxNonce(sender), To: &to, Gas: 100000, GasPrice: b.BaseFee()}))
+	})
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	_, forks, _ := core.GenerateChainWithGenesis(genesis, engine, 3, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(common.Address{2})
+		if i == 0 {
+			b.AddTx(types.MustSignNewTx(key, signer, &types.LegacyTx{To: &receiver, Value: big.NewInt(1000), Gas: params.TxGas, GasPrice: b.BaseFee()}))
+		}
+	})
+	if _, err := chain.InsertChain(forks); err != nil {
+		t.Fatalf("failed to insert fork: %v", err)
+	}
+	records := readStateDiffs(t, dir, format)
+
+	// Genesis, two blocks, two reverts and the three blocks of the fork
+	var kinds []string
+	for _, record := range records {
+		kinds = append(kinds, fmt.Sprintf("%s:%d", record.Type, record.Number))
+	}
+	want := "[block:0 block:1 block:2 revert:2 revert:1 block:1 block:2 block:3]"
+	if have := fmt.Sprint(kinds); have != want {
+		t.Fatalf("unexpected records, want %s, have %s", want, have)
+	}
+	if records[3].Hash != blocks[1].Hash() || records[4].Hash != blocks[0].Hash() {
+		t.Fatal("unexpected reverted blocks")
+	}
+	if len(records[0].Accounts) != len(genesis.Alloc) {
+		t.Fatalf("unexpected genesis accounts: %d", len(records[0].Accounts))
+	}
+	find := func(record *stateDiffRecord, addr common.Address) *stateDiffAccount {
+		for _, account := range record.Accounts {
+			if account.Address == addr {
+				return account
+			}
+		}
+		return nil
+	}
+	// The storage write should be recorded, the reverted one should not
+	if account := find(records[1], storer); account == nil || len(account.Storage) != 1 || account.Storage[0].Value != common.BigToHash(common.Big1) {
+		t.Fatalf("unexpected storage diff: %+v", account)
+	}
+	if account := find(records[2], reverter); account != nil {
+		t.Fatalf("unexpected diff of reverted call: %+v", account)
+	}
+	if account := find(records[2], sender); account == nil || account.Nonce == nil || *account.Nonce != 2 {
+		t.Fatalf("unexpected sender diff: %+v", account)
+	}
+	if account := find(records[5], receiver); account == nil || account.Balance.Cmp(big.NewInt(1000)) != 0 {
+		t.Fatalf("unexpected receiver diff: %+v", account)
+	}
+	// The checkpoint should resume from the head of the fork, skipping the
+	// blocks written already.
+	tracer := newTracer()
+	tracer.OnBlockStart(tracing.BlockEvent{Block: forks[2]})
+	tracer.OnBlockEnd(nil)
+	tracer.OnClose()
+
+	if have := readStateDiffs(t, dir, format); len(have) != len(records) {
+		t.Fatalf("unexpected records after restart: %d", len(have))
+	}
+	blob, err := os.ReadFile(filepath.Join(dir, stateDiffCheckpointName))
+	if err != nil {
+		t.Fatalf("failed to read checkpoint: %v", err)
+	}
+	var checkpoint stateDiffCheckpoint
+	if err := json.Unmarshal(blob, &checkpoint); err != nil {
+		t.Fatalf("failed to parse checkpoint: %v", err)
+	}
+	if n := len(checkpoint.Blocks); n != 4 || checkpoint.Blocks[n-1].Hash != forks[2].Hash() {
+		t.Fatalf("unexpected checkpoint: %+v", checkpoint)
+	}
+}
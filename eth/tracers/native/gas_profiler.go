@@ -0,0 +1,378 @@
+package native
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/google/pprof/profile"
+)
+
+func init() {
+	tracers.DefaultDirectory.Register("gasProfilerTracer", newGasProfilerTracer, false)
+}
+
+// gasProfileEntry is the gas spent by an opcode, aggregated by the contract,
+// the call depth and the function selector of the executing call frame.
+type gasProfileEntry struct {
+	Address  common.Address `json:"address"`
+	Selector string         `json:"selector,omitempty"`
+	Depth    int            `json:"depth"`
+	PC       uint64         `json:"pc"`
+	Op       string         `json:"op"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Count    hexutil.Uint64 `json:"count"`
+}
+
+// gasProfileResult is the result of the gas profiler. All the gas figures are
+// scaled by the token ratio on Mantle, so that they add up to the gas used in
+// the receipt: gasUsed = intrinsicGas + l1Gas + executionGas - refund + dataFloor.
+type gasProfileResult struct {
+	GasUsed      hexutil.Uint64                    `json:"gasUsed"`
+	IntrinsicGas hexutil.Uint64                    `json:"intrinsicGas"`
+	L1Gas        hexutil.Uint64                    `json:"l1Gas"`
+	ExecutionGas hexutil.Uint64                    `json:"executionGas"`
+	Refund       hexutil.Uint64                    `json:"refund"`
+	DataFloor    hexutil.Uint64                    `json:"dataFloor"`
+	TokenRatio   hexutil.Uint64                    `json:"tokenRatio"`
+	Contracts    map[common.Address]hexutil.Uint64 `json:"contracts"`
+	Opcodes      []*gasProfileEntry                `json:"opcodes"`
+	Pprof        []byte                            `json:"pprof,omitempty"`
+}
+
+// gasProfilerConfig is the config of the gas profiler. The profile is base64
+// encoded in the JSON result, `evm run --trace.pprof` writes it out as is.
+type gasProfilerConfig struct {
+	Pprof bool `json:"pprof"` // If true, the gzipped pprof profile is included in the result
+}
+
+// gasSample is the gas spent by an opcode at the given call stack.
+type gasSample struct {
+	stack []*gasFrame // The call frames from the outermost one
+	pc    uint64
+	op    string
+	gas   uint64
+	count uint64
+}
+
+// gasFrame is a call frame tracked by the gas profiler.
+type gasFrame struct {
+	address  common.Address
+	selector string
+	depth    int
+	gas      uint64 // The gas available at the start of the frame
+	childGas uint64 // The gas used by the sub calls of the pending opcode
+	executed bool   // Whether any opcode has been executed
+
+	pending    bool // Whether an opcode is waiting for its cost settled
+	pendingPC  uint64
+	pendingOp  vm.OpCode
+	pendingGas uint64
+}
+
+// name returns the symbol of the call frame in the profile.
+func (f *gasFrame) name() string {
+	if f.selector == "" {
+		return f.address.Hex()
+	}
+	return f.address.Hex() + ":" + f.selector
+}
+
+// gasProfiler aggregates the gas spent by a transaction by the contract, the
+// call depth, the function selector and the opcode. The cost of an opcode is
+// measured as the gas consumed until the next opcode in the same call frame,
+// excluding the gas used by the sub calls, so that the costs of the opcodes
+// sum up to the gas used by the transaction execution.
+type gasProfiler struct {
+	config    gasProfilerConfig
+	stack     []*gasFrame
+	samples   map[string]*gasSample
+	ratio     uint64 // The token ratio scaling the execution gas on Mantle
+	intrinsic uint64 // The intrinsic gas, already scaled
+	available uint64 // The gas available after deducting the intrinsic gas
+	gasLimit  uint64 // The gas available to the outermost call frame
+	execution uint64 // The gas used by the outermost call frame
+	gasUsed   uint64
+	interrupt atomic.Bool
+	reason    error
+}
+
+func newGasProfilerTracer(ctx *tracers.Context, cfg json.RawMessage, chainConfig *params.ChainConfig) (*tracers.Tracer, error) {
+	var config gasProfilerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	t := &gasProfiler{
+		config:  config,
+		samples: make(map[string]*gasSample),
+		ratio:   1,
+	}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart:   t.OnTxStart,
+			OnTxEnd:     t.OnTxEnd,
+			OnEnter:     t.OnEnter,
+			OnExit:      t.OnExit,
+			OnOpcode:    t.OnOpcode,
+			OnGasChange: t.OnGasChange,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+func (t *gasProfiler) OnTxStart(env *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	t.stack, t.samples = nil, make(map[string]*gasSample)
+	t.ratio, t.intrinsic, t.available, t.gasLimit, t.execution, t.gasUsed = 1, 0, 0, 0, 0, 0
+
+	// The execution gas of the non-deposit transactions is charged in the
+	// multiple of the token ratio on Mantle.
+	if tx.Type() != types.DepositTxType {
+		if ratio := env.StateDB.GetState(types.GasOracleAddr, types.TokenRatioSlot).Big().Uint64(); ratio > 0 {
+			t.ratio = ratio
+		}
+	}
+}
+
+func (t *gasProfiler) OnGasChange(old, new uint64, reason tracing.GasChangeReason) {
+	if reason == tracing.GasChangeTxIntrinsicGas {
+		t.intrinsic = old - new
+		t.available = new
+	}
+}
+
+func (t *gasProfiler) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.interrupt.Load() {
+		return
+	}
+	frame := &gasFrame{address: to, depth: depth, gas: gas}
+	if op := vm.OpCode(typ); op == vm.CREATE || op == vm.CREATE2 {
+		frame.selector = "constructor"
+	} else if len(input) >= 4 {
+		frame.selector = hexutil.Encode(input[:4])
+	}
+	if depth == 0 {
+		t.gasLimit = gas
+	}
+	t.stack = append(t.stack, frame)
+}
+
+func (t *gasProfiler) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	if t.interrupt.Load() || len(t.stack) == 0 {
+		return
+	}
+	frame := t.stack[len(t.stack)-1]
+
+	// Settle the last opcode with the gas left at the end of the frame,
+	// or attribute the gas to the frame itself if no code is executed,
+	// e.g. the precompiles.
+	if frame.pending {
+		t.settle(frame, frame.gas-min(gasUsed, frame.gas))
+	} else if !frame.executed && gasUsed > frame.childGas {
+		t.record("PRECOMPILE", 0, gasUsed-frame.childGas)
+	}
+	t.stack = t.stack[:len(t.stack)-1]
+
+	if len(t.stack) > 0 {
+		t.stack[len(t.stack)-1].childGas += gasUsed
+	} else {
+		t.execution = gasUsed
+	}
+}
+
+func (t *gasProfiler) OnOpcode(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, rData []byte, depth int, err error) {
+	if t.interrupt.Load() || len(t.stack) == 0 {
+		return
+	}
+	frame := t.stack[len(t.stack)-1]
+	if frame.pending {
+		t.settle(frame, gas)
+	}
+	frame.executed = true
+	frame.pending = true
+	frame.pendingPC = pc
+	frame.pendingOp = vm.OpCode(op)
+	frame.pendingGas = gas
+}
+
+// settle attributes the gas consumed by the pending opcode of the frame, given
+// the gas left after it, excluding the gas used by its sub calls.
+func (t *gasProfiler) settle(frame *gasFrame, left uint64) {
+	var cost uint64
+	if spent := frame.pendingGas - min(left, frame.pendingGas); spent > frame.childGas {
+		cost = spent - frame.childGas
+	}
+	frame.pending, frame.childGas = false, 0
+	t.record(frame.pendingOp.String(), frame.pendingPC, cost)
+}
+
+// record adds the gas spent by an opcode executed in the innermost frame.
+func (t *gasProfiler) record(op string, pc uint64, gas uint64) {
+	var key strings.Builder
+	for _, frame := range t.stack {
+		key.WriteString(frame.name())
+		key.WriteByte(';')
+	}
+	fmt.Fprintf(&key, "%s@%d", op, pc)
+
+	sample := t.samples[key.String()]
+	if sample == nil {
+		sample = &gasSample{stack: slices.Clone(t.stack), pc: pc, op: op}
+		t.samples[key.String()] = sample
+	}
+	sample.gas += gas
+	sample.count++
+}
+
+func (t *gasProfiler) OnTxEnd(receipt *types.Receipt, err error) {
+	if receipt != nil {
+		t.gasUsed = receipt.GasUsed
+	}
+}
+
+// GetResult returns the json-encoded gas profile, and any error arising from
+// the encoding or forceful termination (via `Stop`).
+func (t *gasProfiler) GetResult() (json.RawMessage, error) {
+	res := &gasProfileResult{
+		GasUsed:      hexutil.Uint64(t.gasUsed),
+		IntrinsicGas: hexutil.Uint64(t.intrinsic),
+		ExecutionGas: hexutil.Uint64(t.execution * t.ratio),
+		TokenRatio:   hexutil.Uint64(t.ratio),
+		Contracts:    make(map[common.Address]hexutil.Uint64),
+		Opcodes:      []*gasProfileEntry{},
+	}
+	// The L1 fee is charged in gas between the intrinsic gas and the
+	// execution, including the remainder of the token ratio division.
+	if t.available > t.gasLimit*t.ratio {
+		res.L1Gas = hexutil.Uint64(t.available - t.gasLimit*t.ratio)
+	}
+	// The remaining difference to the receipt is the gas refund, or the
+	// data floor top-up of EIP-7623.
+	if charged := uint64(res.IntrinsicGas + res.L1Gas + res.ExecutionGas); charged > t.gasUsed {
+		res.Refund = hexutil.Uint64(charged - t.gasUsed)
+	} else {
+		res.DataFloor = hexutil.Uint64(t.gasUsed - charged)
+	}
+	entries := make(map[string]*gasProfileEntry)
+	for _, sample := range t.samples {
+		frame := sample.stack[len(sample.stack)-1]
+		key := fmt.Sprintf("%s;%d;%s@%d", frame.name(), frame.depth, sample.op, sample.pc)
+		entry := entries[key]
+		if entry == nil {
+			entry = &gasProfileEntry{Address: frame.address, Selector: frame.selector, Depth: frame.depth, PC: sample.pc, Op: sample.op}
+			entries[key] = entry
+			res.Opcodes = append(res.Opcodes, entry)
+		}
+		entry.Gas += hexutil.Uint64(sample.gas * t.ratio)
+		entry.Count += hexutil.Uint64(sample.count)
+		res.Contracts[frame.address] += hexutil.Uint64(sample.gas * t.ratio)
+	}
+	slices.SortFunc(res.Opcodes, func(a, b *gasProfileEntry) int {
+		if c := cmp.Compare(b.Gas, a.Gas); c != 0 {
+			return c
+		}
+		if c := bytes.Compare(a.Address[:], b.Address[:]); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Depth, b.Depth); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.PC, b.PC)
+	})
+	if t.config.Pprof {
+		var buf bytes.Buffer
+		if err := t.profile(res).Write(&buf); err != nil {
+			return nil, err
+		}
+		res.Pprof = buf.Bytes()
+	}
+	enc, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return enc, t.reason
+}
+
+// profile builds the pprof profile of the gas usage, which can be rendered as
+// a flame graph by `go tool pprof`. Each call frame is a function in the stack
+// of the samples, while the opcodes are the leaf functions with the program
+// counters as the line numbers.
+func (t *gasProfiler) profile(res *gasProfileResult) *profile.Profile {
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{{Type: "gas", Unit: "count"}, {Type: "executions", Unit: "count"}},
+		PeriodType: &profile.ValueType{Type: "gas", Unit: "count"},
+		Period:     1,
+	}
+	var (
+		funcs = make(map[string]*profile.Function)
+		locs  = make(map[string]*profile.Location)
+	)
+	location := func(name, file string, line int64) *profile.Location {
+		key := fmt.Sprintf("%s;%s;%d", name, file, line)
+		if loc, ok := locs[key]; ok {
+			return loc
+		}
+		fn, ok := funcs[name+";"+file]
+		if !ok {
+			fn = &profile.Function{ID: uint64(len(p.Function) + 1), Name: name, SystemName: name, Filename: file}
+			funcs[name+";"+file] = fn
+			p.Function = append(p.Function, fn)
+		}
+		loc := &profile.Location{ID: uint64(len(p.Location) + 1), Line: []profile.Line{{Function: fn, Line: line}}}
+		locs[key] = loc
+		p.Location = append(p.Location, loc)
+		return loc
+	}
+	for _, part := range []struct {
+		name string
+		gas  hexutil.Uint64
+	}{{"INTRINSIC", res.IntrinsicGas}, {"L1", res.L1Gas}, {"DATAFLOOR", res.DataFloor}} {
+		if part.gas > 0 {
+			p.Sample = append(p.Sample, &profile.Sample{
+				Location: []*profile.Location{location(part.name, "", 0)},
+				Value:    []int64{int64(part.gas), 1},
+			})
+		}
+	}
+	keys := make([]string, 0, len(t.samples))
+	for key := range t.samples {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		var (
+			sample = t.samples[key]
+			frame  = sample.stack[len(sample.stack)-1]
+			stack  = []*profile.Location{location(sample.op, frame.name(), int64(sample.pc))}
+		)
+		for i := len(sample.stack) - 1; i >= 0; i-- {
+			stack = append(stack, location(sample.stack[i].name(), sample.stack[i].address.Hex(), 0))
+		}
+		p.Sample = append(p.Sample, &profile.Sample{
+			Location: stack,
+			Value:    []int64{int64(sample.gas * t.ratio), int64(sample.count)},
+		})
+	}
+	return p
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *gasProfiler) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
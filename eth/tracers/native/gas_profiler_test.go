@@ -0,0 +1,135 @@
+package native_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+)
+
+type gasProfileEntry struct {
+	Address  common.Address `json:"address"`
+	Selector string         `json:"selector"`
+	Depth    int            `json:"depth"`
+	PC       uint64         `json:"pc"`
+	Op       string         `json:"op"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Count    hexutil.Uint64 `json:"count"`
+}
+
+type gasProfileResult struct {
+	GasUsed      hexutil.Uint64                    `json:"gasUsed"`
+	IntrinsicGas hexutil.Uint64                    `json:"intrinsicGas"`
+	L1Gas        hexutil.Uint64                    `json:"l1Gas"`
+	ExecutionGas hexutil.Uint64                    `json:"executionGas"`
+	Refund       hexutil.Uint64                    `json:"refund"`
+	TokenRatio   hexutil.Uint64                    `json:"tokenRatio"`
+	Contracts    map[common.Address]hexutil.Uint64 `json:"contracts"`
+	Opcodes      []*gasProfileEntry                `json:"opcodes"`
+	Pprof        []byte                            `json:"pprof"`
+}
+
+func TestGasProfiler(t *testing.T) {
+	var (
+		receiver = common.HexToAddress("0x1234")
+		// SSTORE(0, 1), CALL(gas=0x100, identity precompile), POP, STOP
+		code = common.FromHex("0x6001600055600060006000600060006004610100f15000")
+	)
+	tracer, err := tracers.DefaultDirectory.New("gasProfilerTracer", &tracers.Context{}, json.RawMessage(`{"pprof":true}`), params.MainnetChainConfig)
+	require.NoError(t, err)
+
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	statedb.SetCode(receiver, code, tracing.CodeChangeUnspecified)
+
+	_, _, err = runtime.Call(receiver, common.FromHex("0xa9059cbb"), &runtime.Config{
+		GasLimit:  100000,
+		State:     statedb,
+		EVMConfig: vm.Config{Tracer: tracer.Hooks},
+	})
+	require.NoError(t, err)
+
+	blob, err := tracer.GetResult()
+	require.NoError(t, err)
+	var res gasProfileResult
+	require.NoError(t, json.Unmarshal(blob, &res))
+
+	require.Equal(t, res.GasUsed, res.ExecutionGas)
+	require.Equal(t, hexutil.Uint64(1), res.TokenRatio)
+
+	var total hexutil.Uint64
+	for _, entry := range res.Opcodes {
+		total += entry.Gas
+	}
+	require.Equal(t, res.GasUsed, total)
+
+	// The most expensive opcode is the storage write of the outermost frame
+	require.Equal(t, &gasProfileEntry{Address: receiver, Selector: "0xa9059cbb", PC: 4, Op: "SSTORE", Gas: 22100, Count: 1}, res.Opcodes[0])
+
+	// The precompile is attributed to itself, the call cost excludes it
+	identity := common.BytesToAddress([]byte{0x4})
+	require.Equal(t, hexutil.Uint64(15), res.Contracts[identity])
+	require.Equal(t, res.GasUsed, res.Contracts[identity]+res.Contracts[receiver])
+
+	// The pprof profile should be parsable, summing up to the same total
+	prof, err := profile.Parse(bytes.NewReader(res.Pprof))
+	require.NoError(t, err)
+	var sum int64
+	for _, sample := range prof.Sample {
+		sum += sample.Value[0]
+	}
+	require.Equal(t, int64(res.GasUsed), sum)
+}
+
+// Tests that the gas is scaled by the token ratio on Mantle, matching the gas
+// used in the receipt.
+func TestGasProfilerTokenRatio(t *testing.T) {
+	const ratio = 3
+
+	tracer, err := tracers.DefaultDirectory.New("gasProfilerTracer", &tracers.Context{}, nil, params.MainnetChainConfig)
+	require.NoError(t, err)
+
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	statedb.SetState(types.GasOracleAddr, types.TokenRatioSlot, common.BytesToHash([]byte{ratio}))
+
+	var (
+		to        = common.HexToAddress("0x1234")
+		gasLimit  = uint64(200000)
+		intrinsic = uint64(21000 * ratio)
+		l1Gas     = uint64(1000)
+		execLimit = (gasLimit - intrinsic - l1Gas) / ratio
+	)
+	tracer.OnTxStart(&tracing.VMContext{StateDB: statedb}, types.NewTx(&types.LegacyTx{To: &to, Gas: gasLimit}), common.Address{})
+	tracer.OnGasChange(gasLimit, gasLimit-intrinsic, tracing.GasChangeTxIntrinsicGas)
+	tracer.OnEnter(0, byte(vm.CALL), common.Address{}, to, nil, execLimit, nil)
+	tracer.OnOpcode(0, byte(vm.PUSH1), execLimit, 3, nil, nil, 1, nil)
+	tracer.OnOpcode(2, byte(vm.STOP), execLimit-3, 0, nil, nil, 1, nil)
+	tracer.OnExit(0, nil, 3, nil, false)
+	// The remainder of the token ratio division is charged as well
+	tracer.OnTxEnd(&types.Receipt{GasUsed: gasLimit - (execLimit-3)*ratio}, nil)
+
+	blob, err := tracer.GetResult()
+	require.NoError(t, err)
+	var res gasProfileResult
+	require.NoError(t, json.Unmarshal(blob, &res))
+
+	require.Equal(t, hexutil.Uint64(ratio), res.TokenRatio)
+	require.Equal(t, hexutil.Uint64(intrinsic), res.IntrinsicGas)
+	require.Equal(t, hexutil.Uint64(3*ratio), res.ExecutionGas)
+	require.Equal(t, hexutil.Uint64(gasLimit-intrinsic-execLimit*ratio), res.L1Gas)
+	require.Equal(t, hexutil.Uint64(0), res.Refund)
+	require.Equal(t, res.GasUsed, res.IntrinsicGas+res.L1Gas+res.ExecutionGas)
+	require.Equal(t, hexutil.Uint64(3*ratio), res.Opcodes[0].Gas)
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func init() {
+	tracers.DefaultDirectory.Register("stateDiffTracer", newStateDiffTracer, false)
+}
+
+// diffValue is a parity style change of a single value. It's encoded as "="
+// if the value is unchanged, {"+": new} if the value is born, {"-": old} if
+// the value is killed and {"*": {"from": old, "to": new}} if it's altered.
+type diffValue struct {
+	kind string
+	from any
+	to   any
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d diffValue) MarshalJSON() ([]byte, error) {
+	switch d.kind {
+	case "+":
+		return json.Marshal(map[string]any{"+": d.to})
+	case "-":
+		return json.Marshal(map[string]any{"-": d.from})
+	case "*":
+		return json.Marshal(map[string]any{"*": map[string]any{"from": d.from, "to": d.to}})
+	default:
+		return json.Marshal("=")
+	}
+}
+
+// accountDiff is the parity style state diff of a single account.
+type accountDiff struct {
+	Balance diffValue                 `json:"balance"`
+	Code    diffValue                 `json:"code"`
+	Nonce   diffValue                 `json:"nonce"`
+	Storage map[common.Hash]diffValue `json:"storage"`
+}
+
+// diffAccount is the state of an account before the transaction execution.
+type diffAccount struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[common.Hash]common.Hash
+}
+
+// exists reports whether the account is non-empty in the sense of EIP-161.
+func (a *diffAccount) exists() bool {
+	return a.nonce > 0 || len(a.code) > 0 || a.balance.Sign() != 0
+}
+
+// stateDiffTracer reports the state changes made by a transaction in the
+// parity stateDiff format. The changes are collected through the state change
+// hooks rather than the opcodes, so that the changes applied outside of the
+// EVM execution are covered as well, e.g. the gas fee debited from the MetaTx
+// sponsor and the BVM_ETH balance slots updated by the state transition.
+type stateDiffTracer struct {
+	env       *tracing.VMContext
+	pre       map[common.Address]*diffAccount
+	diff      map[common.Address]*accountDiff
+	interrupt atomic.Bool // Atomic flag to signal execution interruption
+	reason    error       // Textual reason for the interruption
+}
+
+func newStateDiffTracer(ctx *tracers.Context, cfg json.RawMessage, chainConfig *params.ChainConfig) (*tracers.Tracer, error) {
+	t := &stateDiffTracer{
+		pre:  make(map[common.Address]*diffAccount),
+		diff: make(map[common.Address]*accountDiff),
+	}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart:       t.OnTxStart,
+			OnTxEnd:         t.OnTxEnd,
+			OnBalanceChange: t.OnBalanceChange,
+			OnNonceChange:   t.OnNonceChange,
+			OnCodeChange:    t.OnCodeChange,
+			OnStorageChange: t.OnStorageChange,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+func (t *stateDiffTracer) OnTxStart(env *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	t.env = env
+}
+
+// lookup returns the pre-state of the account, resolving it from the state
+// database if the account is touched for the first time. The state hooks are
+// invoked after the mutation, so the changed field must be corrected with the
+// previous value by the caller if the account is newly resolved.
+func (t *stateDiffTracer) lookup(addr common.Address) (*diffAccount, bool) {
+	if acc, ok := t.pre[addr]; ok {
+		return acc, false
+	}
+	acc := &diffAccount{
+		balance: t.env.StateDB.GetBalance(addr).ToBig(),
+		nonce:   t.env.StateDB.GetNonce(addr),
+		code:    t.env.StateDB.GetCode(addr),
+		storage: make(map[common.Hash]common.Hash),
+	}
+	t.pre[addr] = acc
+	return acc, true
+}
+
+func (t *stateDiffTracer) OnBalanceChange(addr common.Address, prev, new *big.Int, reason tracing.BalanceChangeReason) {
+	if t.interrupt.Load() {
+		return
+	}
+	if acc, created := t.lookup(addr); created {
+		acc.balance = new0(prev)
+	}
+}
+
+func (t *stateDiffTracer) OnNonceChange(addr common.Address, prev, new uint64) {
+	if t.interrupt.Load() {
+		return
+	}
+	if acc, created := t.lookup(addr); created {
+		acc.nonce = prev
+	}
+}
+
+func (t *stateDiffTracer) OnCodeChange(addr common.Address, prevCodeHash common.Hash, prevCode []byte, codeHash common.Hash, code []byte) {
+	if t.interrupt.Load() {
+		return
+	}
+	if acc, created := t.lookup(addr); created {
+		acc.code = prevCode
+	}
+}
+
+func (t *stateDiffTracer) OnStorageChange(addr common.Address, slot common.Hash, prev, new common.Hash) {
+	if t.interrupt.Load() {
+		return
+	}
+	acc, _ := t.lookup(addr)
+	if _, ok := acc.storage[slot]; !ok {
+		acc.storage[slot] = prev
+	}
+}
+
+func (t *stateDiffTracer) OnTxEnd(receipt *types.Receipt, err error) {
+	if err != nil || t.interrupt.Load() {
+		return
+	}
+	for addr, pre := range t.pre {
+		post := &diffAccount{
+			balance: t.env.StateDB.GetBalance(addr).ToBig(),
+			nonce:   t.env.StateDB.GetNonce(addr),
+			code:    t.env.StateDB.GetCode(addr),
+		}
+		// The destructed and the deleted empty accounts are no longer
+		// existent after the finalization.
+		postExist := t.env.StateDB.Exist(addr) && post.exists()
+
+		var diff *accountDiff
+		switch {
+		case !pre.exists() && !postExist:
+			continue
+		case !pre.exists():
+			diff = &accountDiff{
+				Balance: diffValue{kind: "+", to: (*hexutil.Big)(post.balance)},
+				Code:    diffValue{kind: "+", to: hexutil.Bytes(post.code)},
+				Nonce:   diffValue{kind: "+", to: hexutil.Uint64(post.nonce)},
+				Storage: make(map[common.Hash]diffValue),
+			}
+			for slot := range pre.storage {
+				if val := t.env.StateDB.GetState(addr, slot); val != (common.Hash{}) {
+					diff.Storage[slot] = diffValue{kind: "+", to: val}
+				}
+			}
+		case !postExist:
+			diff = &accountDiff{
+				Balance: diffValue{kind: "-", from: (*hexutil.Big)(pre.balance)},
+				Code:    diffValue{kind: "-", from: hexutil.Bytes(pre.code)},
+				Nonce:   diffValue{kind: "-", from: hexutil.Uint64(pre.nonce)},
+				Storage: make(map[common.Hash]diffValue),
+			}
+			for slot, val := range pre.storage {
+				if val != (common.Hash{}) {
+					diff.Storage[slot] = diffValue{kind: "-", from: val}
+				}
+			}
+		default:
+			diff = &accountDiff{Storage: make(map[common.Hash]diffValue)}
+			if pre.balance.Cmp(post.balance) != 0 {
+				diff.Balance = diffValue{kind: "*", from: (*hexutil.Big)(pre.balance), to: (*hexutil.Big)(post.balance)}
+			}
+			if !bytes.Equal(pre.code, post.code) {
+				diff.Code = diffValue{kind: "*", from: hexutil.Bytes(pre.code), to: hexutil.Bytes(post.code)}
+			}
+			if pre.nonce != post.nonce {
+				diff.Nonce = diffValue{kind: "*", from: hexutil.Uint64(pre.nonce), to: hexutil.Uint64(post.nonce)}
+			}
+			for slot, val := range pre.storage {
+				if newVal := t.env.StateDB.GetState(addr, slot); newVal != val {
+					diff.Storage[slot] = diffValue{kind: "*", from: val, to: newVal}
+				}
+			}
+			if diff.Balance.kind == "" && diff.Code.kind == "" && diff.Nonce.kind == "" && len(diff.Storage) == 0 {
+				continue
+			}
+		}
+		t.diff[addr] = diff
+	}
+}
+
+// GetResult returns the json-encoded state diff, and any error arising from
+// the encoding or forceful termination (via `Stop`).
+func (t *stateDiffTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.diff)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *stateDiffTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// new0 returns a copy of the given big integer, treating nil as zero.
+func new0(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(x)
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateDiffTracer(t *testing.T) {
+	var (
+		sponsor  = common.HexToAddress("0x1111")
+		sender   = common.HexToAddress("0x2222")
+		bvmEth   = common.HexToAddress("0xdEAddEaDdeadDEadDEADDEAddEADDEAddead1111")
+		contract = common.HexToAddress("0x3333")
+		victim   = common.HexToAddress("0x4444")
+		touched  = common.HexToAddress("0x5555")
+		slotA    = common.HexToHash("0x01")
+		slotB    = common.HexToHash("0x02")
+	)
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	statedb.SetBalance(sponsor, uint256.NewInt(100), tracing.BalanceChangeUnspecified)
+	statedb.SetBalance(sender, uint256.NewInt(50), tracing.BalanceChangeUnspecified)
+	statedb.SetNonce(sender, 1, tracing.NonceChangeUnspecified)
+	statedb.SetNonce(bvmEth, 1, tracing.NonceChangeUnspecified)
+	statedb.SetState(bvmEth, slotA, common.HexToHash("0x05"))
+	statedb.SetBalance(victim, uint256.NewInt(7), tracing.BalanceChangeUnspecified)
+	statedb.SetCode(victim, []byte{0x60, 0x00}, tracing.CodeChangeUnspecified)
+	statedb.Finalise(true)
+
+	tracer, err := tracers.DefaultDirectory.New("stateDiffTracer", &tracers.Context{}, nil, params.MainnetChainConfig)
+	require.NoError(t, err)
+
+	hooked := state.NewHookedState(statedb, tracer.Hooks)
+	tracer.OnTxStart(&tracing.VMContext{StateDB: hooked}, types.NewTx(&types.LegacyTx{}), sender)
+
+	// The gas fee is debited from the sponsor and the BVM_ETH balance slots
+	// are updated by the state transition.
+	hooked.SubBalance(sponsor, uint256.NewInt(30), tracing.BalanceDecreaseGasBuy)
+	hooked.SetNonce(sender, 2, tracing.NonceChangeEoACall)
+	hooked.SetState(bvmEth, slotA, common.HexToHash("0x03"))
+	hooked.SetState(bvmEth, slotB, common.HexToHash("0x09"))
+
+	hooked.CreateAccount(contract)
+	hooked.CreateContract(contract)
+	hooked.SetNonce(contract, 1, tracing.NonceChangeNewContract)
+	hooked.SetCode(contract, []byte{0x60, 0x01}, tracing.CodeChangeContractCreation)
+	hooked.SetState(contract, slotA, common.HexToHash("0x0a"))
+
+	hooked.SelfDestruct(victim)
+
+	// The empty account is touched and deleted, it must not be reported
+	hooked.AddBalance(touched, uint256.NewInt(1), tracing.BalanceChangeTransfer)
+	hooked.SubBalance(touched, uint256.NewInt(1), tracing.BalanceChangeTransfer)
+
+	hooked.Finalise(true)
+	tracer.OnTxEnd(&types.Receipt{}, nil)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	want := `{
+		"0x0000000000000000000000000000000000001111": {
+			"balance": {"*": {"from": "0x64", "to": "0x46"}}, "code": "=", "nonce": "=", "storage": {}
+		},
+		"0x0000000000000000000000000000000000002222": {
+			"balance": "=", "code": "=", "nonce": {"*": {"from": "0x1", "to": "0x2"}}, "storage": {}
+		},
+		"0x0000000000000000000000000000000000003333": {
+			"balance": {"+": "0x0"}, "code": {"+": "0x6001"}, "nonce": {"+": "0x1"},
+			"storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": {"+": "0x000000000000000000000000000000000000000000000000000000000000000a"}}
+		},
+		"0x0000000000000000000000000000000000004444": {
+			"balance": {"-": "0x7"}, "code": {"-": "0x6000"}, "nonce": {"-": "0x0"}, "storage": {}
+		},
+		"0xdeaddeaddeaddeaddeaddeaddeaddeaddead1111": {
+			"balance": "=", "code": "=", "nonce": "=",
+			"storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000005", "to": "0x0000000000000000000000000000000000000000000000000000000000000003"}},
+				"0x0000000000000000000000000000000000000000000000000000000000000002": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000000", "to": "0x0000000000000000000000000000000000000000000000000000000000000009"}}
+			}
+		}
+	}`
+	var have any
+	require.NoError(t, json.Unmarshal(res, &have))
+	var expected any
+	require.NoError(t, json.Unmarshal([]byte(want), &expected))
+	require.Equal(t, expected, have)
+}
+
+// Tests the state diff of a deposit minting BVM_ETH and transferring it, run
+// through the state transition of a Mantle chain.
+func TestStateDiffTracerBVMETHDeposit(t *testing.T) {
+	var (
+		config = core.DeveloperRollupGenesisBlock(30_000_000, nil).Config
+		bvmEth = common.HexToAddress("0xdEAddEaDdeadDEadDEADDEAddEADDEAddead1111")
+		from   = common.HexToAddress("0x2222")
+		to     = common.HexToAddress("0x3333")
+		header = &types.Header{Number: big.NewInt(1), Time: 1, GasLimit: 30_000_000, BaseFee: big.NewInt(params.InitialBaseFee), Difficulty: new(big.Int)}
+	)
+	// BVM_ETH balances are kept in the mapping at slot 0, the total supply at slot 2.
+	balanceSlot := func(addr common.Address) common.Hash {
+		return crypto.Keccak256Hash(common.LeftPadBytes(addr.Bytes(), 32), make([]byte, 32))
+	}
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	statedb.SetNonce(bvmEth, 1, tracing.NonceChangeUnspecified)
+	statedb.SetState(bvmEth, common.HexToHash("0x02"), common.HexToHash("0x64"))
+	statedb.Finalise(true)
+
+	tracer, err := tracers.DefaultDirectory.New("stateDiffTracer", &tracers.Context{}, nil, config)
+	require.NoError(t, err)
+
+	tx := types.NewTx(&types.DepositTx{
+		SourceHash: common.Hash{0x01},
+		From:       from,
+		To:         &to,
+		Mint:       big.NewInt(1000),
+		Value:      big.NewInt(10),
+		Gas:        100_000,
+		EthValue:   big.NewInt(500),
+		EthTxValue: big.NewInt(200),
+	})
+	var (
+		evm     = vm.NewEVM(core.NewEVMBlockContext(header, nil, &common.Address{}, config, statedb), state.NewHookedState(statedb, tracer.Hooks), config, vm.Config{Tracer: tracer.Hooks})
+		usedGas uint64
+	)
+	receipt, err := core.ApplyTransaction(evm, new(core.GasPool).AddGas(header.GasLimit), statedb, header, tx, &usedGas)
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	// The minted BVM_ETH is credited to the sender and partly transferred to
+	// the recipient along with the native value.
+	want := fmt.Sprintf(`{
+		"0x0000000000000000000000000000000000002222": {
+			"balance": {"+": "0x3de"}, "code": {"+": "0x"}, "nonce": {"+": "0x1"}, "storage": {}
+		},
+		"0x0000000000000000000000000000000000003333": {
+			"balance": {"+": "0xa"}, "code": {"+": "0x"}, "nonce": {"+": "0x0"}, "storage": {}
+		},
+		"0xdeaddeaddeaddeaddeaddeaddeaddeaddead1111": {
+			"balance": "=", "code": "=", "nonce": "=",
+			"storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000002": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000064", "to": "0x0000000000000000000000000000000000000000000000000000000000000258"}},
+				"%s": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000000", "to": "0x000000000000000000000000000000000000000000000000000000000000012c"}},
+				"%s": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000000", "to": "0x00000000000000000000000000000000000000000000000000000000000000c8"}}
+			}
+		}
+	}`, balanceSlot(from).Hex(), balanceSlot(to).Hex())
+	var have any
+	require.NoError(t, json.Unmarshal(res, &have))
+	var expected any
+	require.NoError(t, json.Unmarshal([]byte(want), &expected))
+	require.Equal(t, expected, have)
+}
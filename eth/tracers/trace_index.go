@@ -0,0 +1,568 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// The layout of the trace index table:
+//
+//	'a' + address + num (uint64 big endian) + tx index (uint32 big endian) -> RLP([]traceIndexEntry)
+//	'b' + num (uint64 big endian) -> RLP(traceIndexBlock)
+//	'r' -> RLP(traceIndexRange)
+var (
+	traceIndexAddressPrefix = []byte("a")
+	traceIndexBlockPrefix   = []byte("b")
+	traceIndexRangeKey      = []byte("r")
+)
+
+const (
+	traceIndexFrom uint8 = 1 << iota // The address is the sender of the call frame
+	traceIndexTo                     // The address is the recipient of the call frame
+)
+
+// traceIndexConfig is the trace config used for both indexing the blocks and
+// re-tracing the matched transactions, the trace addresses of the call frames
+// must be identical in both cases.
+func traceIndexConfig() *TraceConfig {
+	tracer := "flatCallTracer"
+	return &TraceConfig{Tracer: &tracer, TracerConfig: json.RawMessage(`{"convertParityErrors":true}`)}
+}
+
+// traceIndexEntry is an indexed call frame of a transaction, identified by its
+// trace address.
+type traceIndexEntry struct {
+	Path  []uint64
+	Flags uint8
+}
+
+// traceIndexBlock is the index metadata of a block, tracking the indexed
+// addresses for pruning and unwinding the block.
+type traceIndexBlock struct {
+	Hash      common.Hash
+	Addresses []common.Address
+}
+
+// traceIndexRange is the range of the indexed blocks [Tail, Next).
+type traceIndexRange struct {
+	Tail uint64
+	Next uint64
+}
+
+// indexedFrame is the subset of a flat call frame relevant for the index.
+type indexedFrame struct {
+	Action struct {
+		From           *common.Address `json:"from"`
+		To             *common.Address `json:"to"`
+		SelfDestructed *common.Address `json:"address"`
+		RefundAddress  *common.Address `json:"refundAddress"`
+	} `json:"action"`
+	Result *struct {
+		Address *common.Address `json:"address"`
+	} `json:"result"`
+	TraceAddress []uint64 `json:"traceAddress"`
+
+	raw json.RawMessage // The encoded call frame
+}
+
+// addresses returns the sender and the recipient of the call frame. The created
+// contract is the recipient of a create frame, and the destructed contract is
+// the sender of a selfdestruct frame.
+func (f *indexedFrame) addresses() (from, to *common.Address) {
+	from, to = f.Action.From, f.Action.To
+	if f.Action.SelfDestructed != nil {
+		from, to = f.Action.SelfDestructed, f.Action.RefundAddress
+	}
+	if to == nil && f.Result != nil {
+		to = f.Result.Address
+	}
+	return from, to
+}
+
+// traceIndexMatch is a call frame matched by the trace filter.
+type traceIndexMatch struct {
+	Number  uint64
+	TxIndex uint32
+	Path    []uint64
+}
+
+// TraceIndexBackend is the backend required by the trace indexer.
+type TraceIndexBackend interface {
+	Backend
+	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// TraceIndexer maintains an index of the call traces of the imported blocks by
+// the addresses involved, so that trace_filter only needs to re-trace the
+// matched transactions instead of replaying the entire block range. Blocks are
+// indexed from the chain head at the time the indexer is first enabled, the
+// blocks imported before are not back filled.
+type TraceIndexer struct {
+	api     *API
+	backend TraceIndexBackend
+	db      ethdb.Database
+	history uint64 // The number of recent blocks to keep indexed, zero for unlimited
+
+	lock    sync.RWMutex
+	indexed *traceIndexRange // The range of indexed blocks, nil if not initialized
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewTraceIndexer creates the trace indexer, keeping the call traces of the
+// given number of recent blocks indexed.
+func NewTraceIndexer(backend TraceIndexBackend, history uint64) *TraceIndexer {
+	ctx, cancel := context.WithCancel(context.Background())
+	ix := &TraceIndexer{
+		api:     NewAPI(backend),
+		backend: backend,
+		db:      rawdb.NewTable(backend.ChainDb(), string(rawdb.TraceIndexPrefix)),
+		history: history,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	if blob, err := ix.db.Get(traceIndexRangeKey); err == nil {
+		var r traceIndexRange
+		if err := rlp.DecodeBytes(blob, &r); err != nil {
+			log.Error("Failed to decode trace index range", "err", err)
+		} else {
+			ix.indexed = &r
+		}
+	}
+	return ix
+}
+
+// Start implements node.Lifecycle, starting the background indexing.
+func (ix *TraceIndexer) Start() error {
+	ix.wg.Add(1)
+	go ix.loop()
+	return nil
+}
+
+// Stop implements node.Lifecycle, terminating the background indexing.
+func (ix *TraceIndexer) Stop() error {
+	ix.cancel()
+	ix.wg.Wait()
+	return nil
+}
+
+func (ix *TraceIndexer) loop() {
+	defer ix.wg.Done()
+
+	headCh := make(chan core.ChainHeadEvent, 10)
+	sub := ix.backend.SubscribeChainHeadEvent(headCh)
+	defer sub.Unsubscribe()
+
+	ix.sync(ix.backend.CurrentHeader())
+	for {
+		select {
+		case <-headCh:
+			// The events might be stale after the catch-up, always sync
+			// to the current head instead.
+			ix.sync(ix.backend.CurrentHeader())
+		case <-sub.Err():
+			return
+		case <-ix.ctx.Done():
+			return
+		}
+	}
+}
+
+// sync unwinds the blocks no longer canonical, indexes the blocks up to the
+// given head and prunes the blocks beyond the configured history.
+func (ix *TraceIndexer) sync(head *types.Header) {
+	if head == nil {
+		return
+	}
+	var (
+		number = head.Number.Uint64()
+		chain  = ix.backend.ChainDb()
+		batch  = ix.db.NewBatch()
+		r      traceIndexRange
+	)
+	if ix.indexed != nil {
+		r = *ix.indexed
+	} else {
+		// The index is started from the current head on, the genesis
+		// block is not traceable.
+		r = traceIndexRange{Tail: max(number, 1), Next: max(number, 1)}
+		log.Info("Initialized call trace index", "number", r.Tail)
+	}
+	for r.Next > r.Tail {
+		hash, err := ix.blockHash(r.Next - 1)
+		if err != nil {
+			log.Error("Failed to read trace index", "number", r.Next-1, "err", err)
+			return
+		}
+		if r.Next-1 <= number && rawdb.ReadCanonicalHash(chain, r.Next-1) == hash {
+			break
+		}
+		if err := ix.deleteBlock(batch, r.Next-1); err != nil {
+			log.Error("Failed to unwind trace index", "number", r.Next-1, "err", err)
+			return
+		}
+		r.Next--
+	}
+	// Skip the blocks falling out of the history window straight away
+	if ix.history != 0 && number >= r.Next+ix.history {
+		r.Next = number + 1 - ix.history
+		r.Tail = r.Next
+		if err := ix.prune(batch, &r); err != nil {
+			log.Error("Failed to prune trace index", "err", err)
+			return
+		}
+	}
+	if err := ix.commit(batch, r); err != nil {
+		return
+	}
+	for r.Next <= number {
+		if ix.ctx.Err() != nil {
+			return
+		}
+		if err := ix.indexBlock(batch, r.Next); err != nil {
+			// Keep the indexed blocks and retry on the next head, the range
+			// of indexed blocks must be contiguous. The block is only skipped
+			// once it falls out of the history window.
+			if ix.ctx.Err() == nil {
+				log.Warn("Failed to index call traces", "number", r.Next, "err", err)
+			}
+			batch.Reset()
+			return
+		}
+		r.Next++
+		if err := ix.prune(batch, &r); err != nil {
+			log.Error("Failed to prune trace index", "err", err)
+			return
+		}
+		if err := ix.commit(batch, r); err != nil {
+			return
+		}
+	}
+}
+
+// commit writes the index changes along with the range of indexed blocks.
+func (ix *TraceIndexer) commit(batch ethdb.Batch, r traceIndexRange) error {
+	blob, err := rlp.EncodeToBytes(&r)
+	if err != nil {
+		log.Crit("Failed to encode trace index range", "err", err)
+	}
+	batch.Put(traceIndexRangeKey, blob)
+
+	ix.lock.Lock()
+	defer ix.lock.Unlock()
+
+	if err := batch.Write(); err != nil {
+		log.Error("Failed to write trace index", "err", err)
+		return err
+	}
+	batch.Reset()
+	ix.indexed = &r
+	return nil
+}
+
+// prune deletes the indexed blocks beyond the configured history, as well as
+// the blocks below the tail after skipping the blocks out of the history window.
+func (ix *TraceIndexer) prune(batch ethdb.Batch, r *traceIndexRange) error {
+	tail := r.Tail
+	if ix.history != 0 && r.Next > tail+ix.history {
+		tail = r.Next - ix.history
+	}
+	it := ix.db.NewIterator(traceIndexBlockPrefix, nil)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(traceIndexBlockPrefix)+8 {
+			continue
+		}
+		number := binary.BigEndian.Uint64(key[len(traceIndexBlockPrefix):])
+		if number >= tail {
+			break
+		}
+		if err := ix.deleteBlock(batch, number); err != nil {
+			return err
+		}
+	}
+	r.Tail = tail
+	return it.Error()
+}
+
+// indexBlock traces the block with the given number and adds its call frames
+// to the index.
+func (ix *TraceIndexer) indexBlock(batch ethdb.Batch, number uint64) error {
+	block, err := ix.backend.BlockByNumber(ix.ctx, rpc.BlockNumber(number))
+	if err != nil {
+		return err
+	}
+	if block == nil {
+		return fmt.Errorf("block #%d not found", number)
+	}
+	results, err := ix.api.traceBlock(ix.ctx, block, traceIndexConfig())
+	if err != nil {
+		return err
+	}
+	traces := make([][]*indexedFrame, len(results))
+	for i, res := range results {
+		if res.Error != "" {
+			return fmt.Errorf("tx %#x: %s", res.TxHash, res.Error)
+		}
+		traces[i], err = decodeIndexedFrames(res.Result)
+		if err != nil {
+			return err
+		}
+	}
+	return writeTraceIndexBlock(batch, number, block.Hash(), traces)
+}
+
+// writeTraceIndexBlock adds the call frames of the transactions in the block
+// to the index.
+func writeTraceIndexBlock(batch ethdb.Batch, number uint64, hash common.Hash, traces [][]*indexedFrame) error {
+	var addresses []common.Address
+	for i, frames := range traces {
+		entries := make(map[common.Address][]traceIndexEntry)
+		for _, frame := range frames {
+			from, to := frame.addresses()
+			if from != nil {
+				entries[*from] = append(entries[*from], traceIndexEntry{Path: frame.TraceAddress, Flags: traceIndexFrom})
+			}
+			if to != nil {
+				// Merge the flags if the frame is a call to itself
+				if n := len(entries[*to]); n > 0 && from != nil && *from == *to {
+					entries[*to][n-1].Flags |= traceIndexTo
+				} else {
+					entries[*to] = append(entries[*to], traceIndexEntry{Path: frame.TraceAddress, Flags: traceIndexTo})
+				}
+			}
+		}
+		for addr, list := range entries {
+			blob, err := rlp.EncodeToBytes(list)
+			if err != nil {
+				return err
+			}
+			batch.Put(traceIndexAddressKey(addr, number, uint32(i)), blob)
+			if !slices.Contains(addresses, addr) {
+				addresses = append(addresses, addr)
+			}
+		}
+	}
+	blob, err := rlp.EncodeToBytes(&traceIndexBlock{Hash: hash, Addresses: addresses})
+	if err != nil {
+		return err
+	}
+	batch.Put(traceIndexBlockKey(number), blob)
+	return nil
+}
+
+// deleteBlock removes the indexed call frames of the block with the given number.
+func (ix *TraceIndexer) deleteBlock(batch ethdb.Batch, number uint64) error {
+	meta, err := ix.readBlock(number)
+	if err != nil {
+		return err
+	}
+	if meta == nil {
+		return nil
+	}
+	for _, addr := range meta.Addresses {
+		prefix := traceIndexAddressKey(addr, number, 0)[:len(traceIndexAddressPrefix)+common.AddressLength+8]
+		it := ix.db.NewIterator(prefix, nil)
+		for it.Next() {
+			batch.Delete(it.Key())
+		}
+		it.Release()
+		if err := it.Error(); err != nil {
+			return err
+		}
+	}
+	batch.Delete(traceIndexBlockKey(number))
+	return nil
+}
+
+// readBlock reads the index metadata of the block with the given number, nil
+// is returned if the block is not indexed.
+func (ix *TraceIndexer) readBlock(number uint64) (*traceIndexBlock, error) {
+	blob, err := ix.db.Get(traceIndexBlockKey(number))
+	if err != nil {
+		return nil, nil
+	}
+	var meta traceIndexBlock
+	if err := rlp.DecodeBytes(blob, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// blockHash returns the hash of the indexed block with the given number.
+func (ix *TraceIndexer) blockHash(number uint64) (common.Hash, error) {
+	meta, err := ix.readBlock(number)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if meta == nil {
+		return common.Hash{}, nil
+	}
+	return meta.Hash, nil
+}
+
+// indexedRange returns the range of indexed blocks [tail, next).
+func (ix *TraceIndexer) indexedRange() (uint64, uint64, bool) {
+	ix.lock.RLock()
+	defer ix.lock.RUnlock()
+
+	if ix.indexed == nil {
+		return 0, 0, false
+	}
+	return ix.indexed.Tail, ix.indexed.Next, true
+}
+
+// filter returns the indexed call frames within the block range [from, to]
+// sent from any of the fromAddrs and sent to any of the toAddrs, in the order
+// of execution. An empty address list matches any address.
+func (ix *TraceIndexer) filter(from, to uint64, fromAddrs, toAddrs []common.Address) ([]*traceIndexMatch, error) {
+	ix.lock.RLock()
+	defer ix.lock.RUnlock()
+
+	type frameKey struct {
+		number  uint64
+		txIndex uint32
+		path    string
+	}
+	type frameMatch struct {
+		*traceIndexMatch
+		from bool
+		to   bool
+	}
+	matches := make(map[frameKey]*frameMatch)
+
+	lookup := func(addr common.Address, flag uint8) error {
+		prefix := traceIndexAddressKey(addr, 0, 0)[:len(traceIndexAddressPrefix)+common.AddressLength]
+		it := ix.db.NewIterator(prefix, binary.BigEndian.AppendUint64(nil, from))
+		defer it.Release()
+
+		for it.Next() {
+			key := it.Key()
+			if len(key) != len(prefix)+12 {
+				continue
+			}
+			number := binary.BigEndian.Uint64(key[len(prefix):])
+			if number > to {
+				break
+			}
+			txIndex := binary.BigEndian.Uint32(key[len(prefix)+8:])
+
+			var entries []traceIndexEntry
+			if err := rlp.DecodeBytes(it.Value(), &entries); err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				if entry.Flags&flag == 0 {
+					continue
+				}
+				key := frameKey{number: number, txIndex: txIndex, path: fmt.Sprint(entry.Path)}
+				m := matches[key]
+				if m == nil {
+					m = &frameMatch{traceIndexMatch: &traceIndexMatch{Number: number, TxIndex: txIndex, Path: entry.Path}}
+					matches[key] = m
+				}
+				if flag == traceIndexFrom {
+					m.from = true
+				} else {
+					m.to = true
+				}
+			}
+		}
+		return it.Error()
+	}
+	for _, addr := range fromAddrs {
+		if err := lookup(addr, traceIndexFrom); err != nil {
+			return nil, err
+		}
+	}
+	for _, addr := range toAddrs {
+		if err := lookup(addr, traceIndexTo); err != nil {
+			return nil, err
+		}
+	}
+	var res []*traceIndexMatch
+	for _, m := range matches {
+		if (len(fromAddrs) == 0 || m.from) && (len(toAddrs) == 0 || m.to) {
+			res = append(res, m.traceIndexMatch)
+		}
+	}
+	// The call frames are emitted in the depth-first order by the tracer,
+	// which is the lexicographical order of the trace addresses.
+	slices.SortFunc(res, func(a, b *traceIndexMatch) int {
+		if a.Number != b.Number {
+			return cmp.Compare(a.Number, b.Number)
+		}
+		if a.TxIndex != b.TxIndex {
+			return cmp.Compare(a.TxIndex, b.TxIndex)
+		}
+		return slices.Compare(a.Path, b.Path)
+	})
+	return res, nil
+}
+
+// decodeIndexedFrames decodes the result of the flat call tracer.
+func decodeIndexedFrames(result interface{}) ([]*indexedFrame, error) {
+	blob, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var raws []json.RawMessage
+	if err := json.Unmarshal(blob, &raws); err != nil {
+		return nil, err
+	}
+	frames := make([]*indexedFrame, len(raws))
+	for i, raw := range raws {
+		frames[i] = &indexedFrame{raw: raw}
+		if err := json.Unmarshal(raw, frames[i]); err != nil {
+			return nil, err
+		}
+	}
+	return frames, nil
+}
+
+// traceIndexAddressKey = traceIndexAddressPrefix + address + num (uint64 big endian) + tx index (uint32 big endian)
+func traceIndexAddressKey(addr common.Address, number uint64, txIndex uint32) []byte {
+	key := append(slices.Clone(traceIndexAddressPrefix), addr.Bytes()...)
+	key = binary.BigEndian.AppendUint64(key, number)
+	return binary.BigEndian.AppendUint32(key, txIndex)
+}
+
+// traceIndexBlockKey = traceIndexBlockPrefix + num (uint64 big endian)
+func traceIndexBlockKey(number uint64) []byte {
+	return binary.BigEndian.AppendUint64(slices.Clone(traceIndexBlockPrefix), number)
+}
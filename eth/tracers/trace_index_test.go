@@ -0,0 +1,248 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func newTestFrame(t *testing.T, from, to common.Address, path ...uint64) *indexedFrame {
+	enc, err := json.Marshal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blob := fmt.Sprintf(`[{"action":{"callType":"call","from":"%s","to":"%s"},"traceAddress":%s,"type":"call"}]`, from.Hex(), to.Hex(), enc)
+	frames, err := decodeIndexedFrames(json.RawMessage(blob))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return frames[0]
+}
+
+func TestTraceIndex(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0xa1")
+		bob   = common.HexToAddress("0xb0b")
+		carol = common.HexToAddress("0xca")
+		ix    = &TraceIndexer{db: rawdb.NewMemoryDatabase(), history: 2}
+		batch = ix.db.NewBatch()
+	)
+	// Block 1: alice -> bob -> carol, bob -> bob
+	// Block 2: carol -> alice
+	// Block 3: bob -> carol
+	blocks := [][][]*indexedFrame{
+		{{newTestFrame(t, alice, bob), newTestFrame(t, bob, carol, 0)}, {newTestFrame(t, bob, bob)}},
+		{{newTestFrame(t, carol, alice)}},
+		{{newTestFrame(t, bob, carol)}},
+	}
+	for i, traces := range blocks {
+		if err := writeTraceIndexBlock(batch, uint64(i+1), common.Hash{byte(i + 1)}, traces); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ix.commit(batch, traceIndexRange{Tail: 1, Next: 4}); err != nil {
+		t.Fatal(err)
+	}
+	match := func(number uint64, txIndex uint32, path ...uint64) *traceIndexMatch {
+		return &traceIndexMatch{Number: number, TxIndex: txIndex, Path: path}
+	}
+	var tests = []struct {
+		from, to  uint64
+		fromAddrs []common.Address
+		toAddrs   []common.Address
+		want      []*traceIndexMatch
+	}{
+		{1, 3, []common.Address{bob}, nil, []*traceIndexMatch{match(1, 0, 0), match(1, 1), match(3, 0)}},
+		{1, 3, nil, []common.Address{bob}, []*traceIndexMatch{match(1, 0), match(1, 1)}},
+		{1, 3, []common.Address{bob}, []common.Address{carol}, []*traceIndexMatch{match(1, 0, 0), match(3, 0)}},
+		{2, 3, nil, []common.Address{alice, carol}, []*traceIndexMatch{match(2, 0), match(3, 0)}},
+		{1, 1, []common.Address{carol}, nil, nil},
+	}
+	for i, test := range tests {
+		have, err := ix.filter(test.from, test.to, test.fromAddrs, test.toAddrs)
+		if err != nil {
+			t.Fatalf("test %d: failed to filter: %v", i, err)
+		}
+		for _, m := range have {
+			if len(m.Path) == 0 {
+				m.Path = nil
+			}
+		}
+		if !reflect.DeepEqual(have, test.want) {
+			t.Fatalf("test %d: unexpected matches, want %v, have %v", i, test.want, have)
+		}
+	}
+	// Prune the blocks beyond the history
+	r := traceIndexRange{Tail: 1, Next: 4}
+	if err := ix.prune(batch, &r); err != nil {
+		t.Fatal(err)
+	}
+	if err := ix.commit(batch, r); err != nil {
+		t.Fatal(err)
+	}
+	if tail, next, _ := ix.indexedRange(); tail != 2 || next != 4 {
+		t.Fatalf("unexpected indexed range [%d, %d)", tail, next)
+	}
+	if have, _ := ix.filter(1, 3, []common.Address{bob}, nil); len(have) != 1 || have[0].Number != 3 {
+		t.Fatalf("unexpected matches after pruning: %v", have)
+	}
+	// Unwind the head block
+	if err := ix.deleteBlock(batch, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := ix.commit(batch, traceIndexRange{Tail: 2, Next: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if have, _ := ix.filter(1, 3, []common.Address{bob}, nil); len(have) != 0 {
+		t.Fatalf("unexpected matches after unwinding: %v", have)
+	}
+	it := ix.db.NewIterator(nil, nil)
+	defer it.Release()
+
+	var keys int
+	for it.Next() {
+		keys++
+	}
+	// The range, the block metadata and the entries of carol and alice
+	if keys != 4 {
+		t.Fatalf("unexpected number of index entries, want 4, have %d", keys)
+	}
+}
+
+// flakyIndexBackend fails to retrieve a given block once.
+type flakyIndexBackend struct {
+	*testBackend
+	fail rpc.BlockNumber // The block failing to be retrieved, zero for none
+}
+
+func (b *flakyIndexBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
+	if number == b.fail {
+		b.fail = 0
+		return nil, errors.New("block unavailable")
+	}
+	return b.testBackend.BlockByNumber(ctx, number)
+}
+
+func (b *flakyIndexBackend) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	})
+}
+
+// TestTraceIndexRetry checks that the indexed blocks are kept if the next block
+// fails to be indexed, and that the block is indexed on the next head.
+func TestTraceIndexRetry(t *testing.T) {
+	var (
+		alice   = common.HexToAddress("0xa1")
+		bob     = common.HexToAddress("0xb0b")
+		genesis = &core.Genesis{Config: params.TestChainConfig}
+		backend = &flakyIndexBackend{testBackend: newTestBackend(t, 5, genesis, func(i int, b *core.BlockGen) {})}
+		ix      = NewTraceIndexer(backend, 0)
+		batch   = ix.db.NewBatch()
+	)
+	defer backend.teardown()
+
+	// Blocks 1-3 are indexed already.
+	for n := uint64(1); n <= 3; n++ {
+		traces := [][]*indexedFrame{{newTestFrame(t, alice, bob)}}
+		if err := writeTraceIndexBlock(batch, n, backend.chain.GetHeaderByNumber(n).Hash(), traces); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ix.commit(batch, traceIndexRange{Tail: 1, Next: 4}); err != nil {
+		t.Fatal(err)
+	}
+	head := backend.chain.CurrentHeader()
+
+	backend.fail = 4
+	ix.sync(head)
+	if tail, next, _ := ix.indexedRange(); tail != 1 || next != 4 {
+		t.Fatalf("unexpected indexed range after failure [%d, %d)", tail, next)
+	}
+	if have, _ := ix.filter(1, 3, []common.Address{alice}, nil); len(have) != 3 {
+		t.Fatalf("indexed blocks lost after failure, have %d matches", len(have))
+	}
+	ix.sync(head)
+	if tail, next, _ := ix.indexedRange(); tail != 1 || next != 6 {
+		t.Fatalf("unexpected indexed range after retry [%d, %d)", tail, next)
+	}
+	if have, _ := ix.filter(1, 5, []common.Address{alice}, nil); len(have) != 3 {
+		t.Fatalf("indexed blocks lost after retry, have %d matches", len(have))
+	}
+}
+
+func TestTraceFilterLimits(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0xa1")
+		bob   = common.HexToAddress("0xb0b")
+		ix    = &TraceIndexer{db: rawdb.NewMemoryDatabase()}
+		batch = ix.db.NewBatch()
+		api   = NewTraceAPI(nil, ix)
+	)
+	// Block 1 holds more transactions calling bob than returned at once.
+	traces := make([][]*indexedFrame, maxTraceFilterResults+1)
+	for i := range traces {
+		traces[i] = []*indexedFrame{newTestFrame(t, alice, bob)}
+	}
+	if err := writeTraceIndexBlock(batch, 1, common.Hash{1}, traces); err != nil {
+		t.Fatal(err)
+	}
+	if err := ix.commit(batch, traceIndexRange{Tail: 1, Next: 2 * maxTraceFilterBlocks}); err != nil {
+		t.Fatal(err)
+	}
+	block := func(n int64) *rpc.BlockNumber {
+		number := rpc.BlockNumber(n)
+		return &number
+	}
+	count := func(n uint64) *uint64 { return &n }
+	var tests = []struct {
+		args TraceFilterArgs
+		err  string
+	}{
+		{
+			args: TraceFilterArgs{FromBlock: block(1), ToBlock: block(maxTraceFilterBlocks + 1), ToAddress: []common.Address{bob}},
+			err:  "block range [1, 10001] exceeds the limit of 10000 blocks",
+		},
+		{
+			args: TraceFilterArgs{FromBlock: block(1), ToBlock: block(1), ToAddress: []common.Address{bob}},
+			err:  "1001 traces matched, exceeding the limit of 1000 traces, use after and count to page the results",
+		},
+		{
+			args: TraceFilterArgs{FromBlock: block(1), ToBlock: block(1), ToAddress: []common.Address{bob}, Count: count(maxTraceFilterResults + 1)},
+			err:  "count 1001 exceeds the limit of 1000 traces",
+		},
+	}
+	for i, test := range tests {
+		if _, err := api.Filter(context.Background(), test.args); err == nil || err.Error() != test.err {
+			t.Errorf("test %d: unexpected error, want %q, have %v", i, test.err, err)
+		}
+	}
+}
@@ -850,7 +850,9 @@ func testGetBlockRangeRPC(t *testing.T, client *rpc.Client) {
 		{"0-0", args{"0x0", "0x0", true}, 1, false, ""},
 		{"0-1", args{"0x0", "0x1", true}, 2, false, ""},
 		{"0-2", args{"0x0", "0x2", true}, 3, false, ""},
-		{"0-30", args{"0x0", "0x1e", true}, 0, true, "block in range not indexed, this should never happen"},
+		{"0-30", args{"0x0", "0x1e", true}, 0, true, "end of block range (30) is beyond the head block"},
+		{"0-1000", args{"0x0", "0x3e8", true}, 0, true, "end of block range (1000) is beyond the head block"},
+		{"0-1001", args{"0x0", "0x3e9", true}, 0, true, "requested block range is too large (max is 1000, requested 1001 blocks)"},
 		{"0-1030", args{"0x0", "0x406", true}, 0, true, "requested block range is too large (max is 1000"},
 	}
 	for _, tt := range tests {
@@ -870,6 +872,55 @@ func testGetBlockRangeRPC(t *testing.T, client *rpc.Client) {
 			}
 		})
 	}
+	// Receipts are included on request, and the blocks are returned in order.
+	var blocks []map[string]interface{}
+	if err := client.CallContext(context.Background(), &blocks, "eth_getBlockRange", "0x1", "0x3", false, true); err != nil {
+		t.Fatalf("failed to get block range with receipts: %v", err)
+	}
+	checkBlocks := func(blocks []map[string]interface{}) {
+		if len(blocks) != 3 {
+			t.Fatalf("have %d blocks, want 3", len(blocks))
+		}
+		for i, block := range blocks {
+			if have, want := block["number"], hexutil.EncodeUint64(uint64(i+1)); have != want {
+				t.Errorf("block %d: have number %v, want %v", i, have, want)
+			}
+			receipts, ok := block["receipts"].([]interface{})
+			if txs := block["transactions"].([]interface{}); !ok || len(receipts) != len(txs) {
+				t.Errorf("block %d: have %d receipts, want %d", i, len(receipts), len(txs))
+			}
+		}
+	}
+	checkBlocks(blocks)
+
+	// The subscription streams the same blocks.
+	type page struct {
+		Blocks []map[string]interface{}
+		Last   bool
+		Error  string
+	}
+	pages := make(chan page)
+	sub, err := client.EthSubscribe(context.Background(), pages, "blockRange", "0x1", "0x3", false, true)
+	if err != nil {
+		t.Fatalf("failed to subscribe to block range: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	var streamed []map[string]interface{}
+	for done := false; !done; {
+		select {
+		case p := <-pages:
+			if p.Error != "" {
+				t.Fatalf("block range stream failed: %v", p.Error)
+			}
+			streamed, done = append(streamed, p.Blocks...), p.Last
+		case err := <-sub.Err():
+			t.Fatalf("block range subscription failed: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("block range not streamed")
+		}
+	}
+	checkBlocks(streamed)
 }
 
 func testHistoricalRPC(t *testing.T, client *rpc.Client) {
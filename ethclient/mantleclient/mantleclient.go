@@ -0,0 +1,147 @@
+// Package mantleclient provides an RPC client for Mantle-specific APIs.
+package mantleclient
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Client is a wrapper around rpc.Client that implements Mantle-specific functionality.
+//
+// If you want to use the standardized Ethereum RPC functionality, use ethclient.Client instead.
+type Client struct {
+	c *rpc.Client
+}
+
+// New creates a client that uses the given RPC client.
+func New(c *rpc.Client) *Client {
+	return &Client{c}
+}
+
+// SendTransactionWithPreconf injects a signed transaction into the pending pool
+// and waits for its preconfirmation by the sequencer.
+func (mc *Client) SendTransactionWithPreconf(ctx context.Context, tx *types.Transaction) (*core.NewPreconfTxEvent, error) {
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var result *core.NewPreconfTxEvent
+	if err := mc.c.CallContext(ctx, &result, "eth_sendRawTransactionWithPreconf", hexutil.Encode(data)); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ethereum.NotFound
+	}
+	return result, nil
+}
+
+// PreconfQuery defines criteria for the preconf transactions subscription. If
+// TransactionHashes is empty, the results of all preconf transactions are
+// delivered, otherwise only the results of the given transactions. Likewise,
+// Statuses restricts the delivered results to the given preconf statuses.
+type PreconfQuery struct {
+	TransactionHashes []common.Hash        `json:"transactionHashes,omitempty"`
+	Statuses          []core.PreconfStatus `json:"statuses,omitempty"`
+}
+
+// SubscribeNewPreconfTransactions subscribes to the preconf results of the
+// transactions entering the transaction pool. If q is nil, all results are
+// delivered.
+func (mc *Client) SubscribeNewPreconfTransactions(ctx context.Context, q *PreconfQuery, ch chan<- core.NewPreconfTxEvent) (ethereum.Subscription, error) {
+	// Only send the filter if there is one, keeping compatibility with nodes
+	// not supporting it.
+	if q == nil || (len(q.TransactionHashes) == 0 && len(q.Statuses) == 0) {
+		return mc.c.EthSubscribe(ctx, ch, "newPreconfTransaction")
+	}
+	return mc.c.EthSubscribe(ctx, ch, "newPreconfTransaction", q)
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash.
+// On top of the standard fields, the receipt carries the L1 fee charged to the
+// transaction (L1GasPrice, L1GasUsed, L1Fee, FeeScalar and TokenRatio) or, for
+// deposit transactions, the DepositNonce.
+func (mc *Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	var r *types.Receipt
+	err := mc.c.CallContext(ctx, &r, "eth_getTransactionReceipt", txHash)
+	if err == nil && r == nil {
+		return nil, ethereum.NotFound
+	}
+	return r, err
+}
+
+// L1FeeInfo holds the parameters of the L1 data fee in effect after a block.
+type L1FeeInfo struct {
+	L1BaseFee  *big.Int   // L1 base fee reported by the L1 attributes deposit
+	Overhead   *big.Int   // Fixed L1 gas overhead charged per transaction
+	Scalar     *big.Int   // Fee scalar, scaled up by 1e6
+	FeeScalar  *big.Float // Fee scalar, as reported in the receipts
+	TokenRatio *big.Int   // MNT/ETH price ratio set in the gas price oracle
+}
+
+// L1Cost returns the L1 data fee of a transaction with the given rollup data
+// gas, in MNT wei.
+func (info *L1FeeInfo) L1Cost(rollupDataGas uint64) *big.Int {
+	return types.L1Cost(rollupDataGas, info.L1BaseFee, info.Overhead, info.Scalar, info.TokenRatio)
+}
+
+// L1FeeInfo returns the L1 fee parameters in effect after the given block, read
+// from the L1Block and GasPriceOracle predeploys.
+func (mc *Client) L1FeeInfo(ctx context.Context, blockHash common.Hash) (*L1FeeInfo, error) {
+	var (
+		block = rpc.BlockNumberOrHashWithHash(blockHash, false)
+		slots = []struct {
+			addr common.Address
+			key  common.Hash
+		}{
+			{types.L1BlockAddr, types.L1BaseFeeSlot},
+			{types.L1BlockAddr, types.OverheadSlot},
+			{types.L1BlockAddr, types.ScalarSlot},
+			{types.GasOracleAddr, types.TokenRatioSlot},
+		}
+		results = make([]common.Hash, len(slots))
+		reqs    = make([]rpc.BatchElem, len(slots))
+	)
+	for i, slot := range slots {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_getStorageAt",
+			Args:   []any{slot.addr, slot.key, block},
+			Result: &results[i],
+		}
+	}
+	if err := mc.c.BatchCallContext(ctx, reqs); err != nil {
+		return nil, err
+	}
+	state := make(storage)
+	for i, req := range reqs {
+		if req.Error != nil {
+			return nil, req.Error
+		}
+		if state[slots[i].addr] == nil {
+			state[slots[i].addr] = make(map[common.Hash]common.Hash)
+		}
+		state[slots[i].addr][slots[i].key] = results[i]
+	}
+	l1BaseFee, overhead, scalar, feeScalar, tokenRatio := types.DeriveL1GasInfo(state)
+	return &L1FeeInfo{
+		L1BaseFee:  l1BaseFee,
+		Overhead:   overhead,
+		Scalar:     scalar,
+		FeeScalar:  feeScalar,
+		TokenRatio: tokenRatio,
+	}, nil
+}
+
+// storage serves the storage slots retrieved from the node to the L1 fee
+// derivation.
+type storage map[common.Address]map[common.Hash]common.Hash
+
+func (s storage) GetState(addr common.Address, key common.Hash) common.Hash {
+	return s[addr][key]
+}
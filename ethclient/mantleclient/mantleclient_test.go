@@ -0,0 +1,108 @@
+package mantleclient
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// testPreconfService mimics the preconf endpoints of a Mantle node, reporting
+// the preconf results of the submitted transactions to the subscribers.
+type testPreconfService struct {
+	results chan core.NewPreconfTxEvent
+}
+
+func (s *testPreconfService) SendRawTransactionWithPreconf(input hexutil.Bytes) (*core.NewPreconfTxEvent, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return nil, err
+	}
+	status := core.PreconfStatusSuccess
+	if tx.Nonce()%2 == 1 {
+		status = core.PreconfStatusFailed
+	}
+	result := &core.NewPreconfTxEvent{TxHash: tx.Hash(), Status: status, PredictedL2BlockNumber: 1}
+	s.results <- *result
+	return result, nil
+}
+
+func (s *testPreconfService) NewPreconfTransaction(ctx context.Context, filter *filters.PreconfTransactionsQuery) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	go func() {
+		for {
+			select {
+			case ev := <-s.results:
+				if filter != nil && len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, ev.Status) {
+					continue
+				}
+				notifier.Notify(sub.ID, ev)
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+func newTestClient(t *testing.T) *Client {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &testPreconfService{results: make(chan core.NewPreconfTxEvent, 16)}); err != nil {
+		t.Fatal(err)
+	}
+	client := rpc.DialInProc(server)
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+	return New(client)
+}
+
+func TestSendTransactionWithPreconf(t *testing.T) {
+	var (
+		ec     = newTestClient(t)
+		key, _ = crypto.GenerateKey()
+		signer = types.LatestSignerForChainID(big.NewInt(1))
+		events = make(chan core.NewPreconfTxEvent)
+	)
+	sub, err := ec.SubscribeNewPreconfTransactions(context.Background(), &PreconfQuery{Statuses: []core.PreconfStatus{core.PreconfStatusFailed}}, events)
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	var failed common.Hash
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx := types.MustSignNewTx(key, signer, &types.DynamicFeeTx{ChainID: big.NewInt(1), Nonce: nonce, Gas: 21000})
+		result, err := ec.SendTransactionWithPreconf(context.Background(), tx)
+		if err != nil {
+			t.Fatalf("failed to send transaction: %v", err)
+		}
+		if result.TxHash != tx.Hash() || result.PredictedL2BlockNumber != 1 {
+			t.Fatalf("unexpected preconf result: %+v", result)
+		}
+		if result.Status == core.PreconfStatusFailed {
+			failed = tx.Hash()
+		}
+	}
+	select {
+	case ev := <-events:
+		if ev.TxHash != failed || ev.Status != core.PreconfStatusFailed {
+			t.Fatalf("have event %+v, want failed preconf of %x", ev, failed)
+		}
+	case err := <-sub.Err():
+		t.Fatalf("subscription failed: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for preconf event")
+	}
+}
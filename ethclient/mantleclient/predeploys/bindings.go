@@ -0,0 +1,2039 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package predeploys
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// BVMETHMetaData contains all meta data concerning the BVMETH contract.
+var BVMETHMetaData = bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Burn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Mint\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ID:  "598291f416e4e267322122ae44d62fe38d",
+}
+
+// BVMETH is an auto generated Go binding around an Ethereum contract.
+type BVMETH struct {
+	abi abi.ABI
+}
+
+// NewBVMETH creates a new instance of BVMETH.
+func NewBVMETH() *BVMETH {
+	parsed, err := BVMETHMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &BVMETH{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *BVMETH) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackAllowance is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xdd62ed3e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (bVMETH *BVMETH) PackAllowance(owner common.Address, spender common.Address) []byte {
+	enc, err := bVMETH.abi.Pack("allowance", owner, spender)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackAllowance is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xdd62ed3e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (bVMETH *BVMETH) TryPackAllowance(owner common.Address, spender common.Address) ([]byte, error) {
+	return bVMETH.abi.Pack("allowance", owner, spender)
+}
+
+// UnpackAllowance is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (bVMETH *BVMETH) UnpackAllowance(data []byte) (*big.Int, error) {
+	out, err := bVMETH.abi.Unpack("allowance", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackApprove is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x095ea7b3.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) PackApprove(spender common.Address, amount *big.Int) []byte {
+	enc, err := bVMETH.abi.Pack("approve", spender, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackApprove is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x095ea7b3.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) TryPackApprove(spender common.Address, amount *big.Int) ([]byte, error) {
+	return bVMETH.abi.Pack("approve", spender, amount)
+}
+
+// UnpackApprove is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) UnpackApprove(data []byte) (bool, error) {
+	out, err := bVMETH.abi.Unpack("approve", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, nil
+}
+
+// PackBalanceOf is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x70a08231.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (bVMETH *BVMETH) PackBalanceOf(account common.Address) []byte {
+	enc, err := bVMETH.abi.Pack("balanceOf", account)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackBalanceOf is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x70a08231.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (bVMETH *BVMETH) TryPackBalanceOf(account common.Address) ([]byte, error) {
+	return bVMETH.abi.Pack("balanceOf", account)
+}
+
+// UnpackBalanceOf is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (bVMETH *BVMETH) UnpackBalanceOf(data []byte) (*big.Int, error) {
+	out, err := bVMETH.abi.Unpack("balanceOf", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackDecimals is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x313ce567.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function decimals() view returns(uint8)
+func (bVMETH *BVMETH) PackDecimals() []byte {
+	enc, err := bVMETH.abi.Pack("decimals")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackDecimals is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x313ce567.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function decimals() view returns(uint8)
+func (bVMETH *BVMETH) TryPackDecimals() ([]byte, error) {
+	return bVMETH.abi.Pack("decimals")
+}
+
+// UnpackDecimals is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (bVMETH *BVMETH) UnpackDecimals(data []byte) (uint8, error) {
+	out, err := bVMETH.abi.Unpack("decimals", data)
+	if err != nil {
+		return *new(uint8), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	return out0, nil
+}
+
+// PackName is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x06fdde03.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function name() view returns(string)
+func (bVMETH *BVMETH) PackName() []byte {
+	enc, err := bVMETH.abi.Pack("name")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackName is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x06fdde03.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function name() view returns(string)
+func (bVMETH *BVMETH) TryPackName() ([]byte, error) {
+	return bVMETH.abi.Pack("name")
+}
+
+// UnpackName is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (bVMETH *BVMETH) UnpackName(data []byte) (string, error) {
+	out, err := bVMETH.abi.Unpack("name", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackSymbol is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x95d89b41.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function symbol() view returns(string)
+func (bVMETH *BVMETH) PackSymbol() []byte {
+	enc, err := bVMETH.abi.Pack("symbol")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSymbol is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x95d89b41.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function symbol() view returns(string)
+func (bVMETH *BVMETH) TryPackSymbol() ([]byte, error) {
+	return bVMETH.abi.Pack("symbol")
+}
+
+// UnpackSymbol is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (bVMETH *BVMETH) UnpackSymbol(data []byte) (string, error) {
+	out, err := bVMETH.abi.Unpack("symbol", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackTotalSupply is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x18160ddd.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (bVMETH *BVMETH) PackTotalSupply() []byte {
+	enc, err := bVMETH.abi.Pack("totalSupply")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTotalSupply is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x18160ddd.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (bVMETH *BVMETH) TryPackTotalSupply() ([]byte, error) {
+	return bVMETH.abi.Pack("totalSupply")
+}
+
+// UnpackTotalSupply is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (bVMETH *BVMETH) UnpackTotalSupply(data []byte) (*big.Int, error) {
+	out, err := bVMETH.abi.Unpack("totalSupply", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackTransfer is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa9059cbb.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) PackTransfer(to common.Address, amount *big.Int) []byte {
+	enc, err := bVMETH.abi.Pack("transfer", to, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTransfer is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa9059cbb.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) TryPackTransfer(to common.Address, amount *big.Int) ([]byte, error) {
+	return bVMETH.abi.Pack("transfer", to, amount)
+}
+
+// UnpackTransfer is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) UnpackTransfer(data []byte) (bool, error) {
+	out, err := bVMETH.abi.Unpack("transfer", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, nil
+}
+
+// PackTransferFrom is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x23b872dd.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) PackTransferFrom(from common.Address, to common.Address, amount *big.Int) []byte {
+	enc, err := bVMETH.abi.Pack("transferFrom", from, to, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTransferFrom is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x23b872dd.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) TryPackTransferFrom(from common.Address, to common.Address, amount *big.Int) ([]byte, error) {
+	return bVMETH.abi.Pack("transferFrom", from, to, amount)
+}
+
+// UnpackTransferFrom is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (bVMETH *BVMETH) UnpackTransferFrom(data []byte) (bool, error) {
+	out, err := bVMETH.abi.Unpack("transferFrom", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, nil
+}
+
+// BVMETHApproval represents a Approval event raised by the BVMETH contract.
+type BVMETHApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const BVMETHApprovalEventName = "Approval"
+
+// ContractEventName returns the user-defined event name.
+func (BVMETHApproval) ContractEventName() string {
+	return BVMETHApprovalEventName
+}
+
+// UnpackApprovalEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (bVMETH *BVMETH) UnpackApprovalEvent(log *types.Log) (*BVMETHApproval, error) {
+	event := "Approval"
+	if len(log.Topics) == 0 || log.Topics[0] != bVMETH.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(BVMETHApproval)
+	if len(log.Data) > 0 {
+		if err := bVMETH.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range bVMETH.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// BVMETHBurn represents a Burn event raised by the BVMETH contract.
+type BVMETHBurn struct {
+	Account common.Address
+	Amount  *big.Int
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const BVMETHBurnEventName = "Burn"
+
+// ContractEventName returns the user-defined event name.
+func (BVMETHBurn) ContractEventName() string {
+	return BVMETHBurnEventName
+}
+
+// UnpackBurnEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Burn(address indexed account, uint256 amount)
+func (bVMETH *BVMETH) UnpackBurnEvent(log *types.Log) (*BVMETHBurn, error) {
+	event := "Burn"
+	if len(log.Topics) == 0 || log.Topics[0] != bVMETH.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(BVMETHBurn)
+	if len(log.Data) > 0 {
+		if err := bVMETH.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range bVMETH.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// BVMETHMint represents a Mint event raised by the BVMETH contract.
+type BVMETHMint struct {
+	Account common.Address
+	Amount  *big.Int
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const BVMETHMintEventName = "Mint"
+
+// ContractEventName returns the user-defined event name.
+func (BVMETHMint) ContractEventName() string {
+	return BVMETHMintEventName
+}
+
+// UnpackMintEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Mint(address indexed account, uint256 amount)
+func (bVMETH *BVMETH) UnpackMintEvent(log *types.Log) (*BVMETHMint, error) {
+	event := "Mint"
+	if len(log.Topics) == 0 || log.Topics[0] != bVMETH.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(BVMETHMint)
+	if len(log.Data) > 0 {
+		if err := bVMETH.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range bVMETH.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// BVMETHTransfer represents a Transfer event raised by the BVMETH contract.
+type BVMETHTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   *types.Log // Blockchain specific contextual infos
+}
+
+const BVMETHTransferEventName = "Transfer"
+
+// ContractEventName returns the user-defined event name.
+func (BVMETHTransfer) ContractEventName() string {
+	return BVMETHTransferEventName
+}
+
+// UnpackTransferEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (bVMETH *BVMETH) UnpackTransferEvent(log *types.Log) (*BVMETHTransfer, error) {
+	event := "Transfer"
+	if len(log.Topics) == 0 || log.Topics[0] != bVMETH.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(BVMETHTransfer)
+	if len(log.Data) > 0 {
+		if err := bVMETH.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range bVMETH.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// GasPriceOracleMetaData contains all meta data concerning the GasPriceOracle contract.
+var GasPriceOracleMetaData = bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousOperator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newOperator\",\"type\":\"address\"}],\"name\":\"OperatorUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"previousTokenRatio\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newTokenRatio\",\"type\":\"uint256\"}],\"name\":\"TokenRatioUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"baseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"gasPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"getL1Fee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"getL1GasUsed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l1BaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"operator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"overhead\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"scalar\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_operator\",\"type\":\"address\"}],\"name\":\"setOperator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_tokenRatio\",\"type\":\"uint256\"}],\"name\":\"setTokenRatio\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenRatio\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ID:  "e604aec9fe483a964819cb6587a5182ee6",
+}
+
+// GasPriceOracle is an auto generated Go binding around an Ethereum contract.
+type GasPriceOracle struct {
+	abi abi.ABI
+}
+
+// NewGasPriceOracle creates a new instance of GasPriceOracle.
+func NewGasPriceOracle() *GasPriceOracle {
+	parsed, err := GasPriceOracleMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &GasPriceOracle{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *GasPriceOracle) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackBaseFee is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x6ef25c3a.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function baseFee() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackBaseFee() []byte {
+	enc, err := gasPriceOracle.abi.Pack("baseFee")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackBaseFee is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x6ef25c3a.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function baseFee() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackBaseFee() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("baseFee")
+}
+
+// UnpackBaseFee is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x6ef25c3a.
+//
+// Solidity: function baseFee() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackBaseFee(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("baseFee", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackDecimals is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x313ce567.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function decimals() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackDecimals() []byte {
+	enc, err := gasPriceOracle.abi.Pack("decimals")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackDecimals is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x313ce567.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function decimals() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackDecimals() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("decimals")
+}
+
+// UnpackDecimals is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackDecimals(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("decimals", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackGasPrice is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xfe173b97.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function gasPrice() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackGasPrice() []byte {
+	enc, err := gasPriceOracle.abi.Pack("gasPrice")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGasPrice is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xfe173b97.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function gasPrice() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackGasPrice() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("gasPrice")
+}
+
+// UnpackGasPrice is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xfe173b97.
+//
+// Solidity: function gasPrice() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackGasPrice(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("gasPrice", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackGetL1Fee is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x49948e0e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getL1Fee(bytes _data) view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackGetL1Fee(data []byte) []byte {
+	enc, err := gasPriceOracle.abi.Pack("getL1Fee", data)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetL1Fee is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x49948e0e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getL1Fee(bytes _data) view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackGetL1Fee(data []byte) ([]byte, error) {
+	return gasPriceOracle.abi.Pack("getL1Fee", data)
+}
+
+// UnpackGetL1Fee is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x49948e0e.
+//
+// Solidity: function getL1Fee(bytes _data) view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackGetL1Fee(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("getL1Fee", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackGetL1GasUsed is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xde26c4a1.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getL1GasUsed(bytes _data) view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackGetL1GasUsed(data []byte) []byte {
+	enc, err := gasPriceOracle.abi.Pack("getL1GasUsed", data)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetL1GasUsed is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xde26c4a1.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getL1GasUsed(bytes _data) view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackGetL1GasUsed(data []byte) ([]byte, error) {
+	return gasPriceOracle.abi.Pack("getL1GasUsed", data)
+}
+
+// UnpackGetL1GasUsed is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xde26c4a1.
+//
+// Solidity: function getL1GasUsed(bytes _data) view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackGetL1GasUsed(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("getL1GasUsed", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackL1BaseFee is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x519b4bd3.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function l1BaseFee() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackL1BaseFee() []byte {
+	enc, err := gasPriceOracle.abi.Pack("l1BaseFee")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackL1BaseFee is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x519b4bd3.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function l1BaseFee() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackL1BaseFee() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("l1BaseFee")
+}
+
+// UnpackL1BaseFee is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x519b4bd3.
+//
+// Solidity: function l1BaseFee() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackL1BaseFee(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("l1BaseFee", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackOperator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x570ca735.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function operator() view returns(address)
+func (gasPriceOracle *GasPriceOracle) PackOperator() []byte {
+	enc, err := gasPriceOracle.abi.Pack("operator")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackOperator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x570ca735.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function operator() view returns(address)
+func (gasPriceOracle *GasPriceOracle) TryPackOperator() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("operator")
+}
+
+// UnpackOperator is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (gasPriceOracle *GasPriceOracle) UnpackOperator(data []byte) (common.Address, error) {
+	out, err := gasPriceOracle.abi.Unpack("operator", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackOverhead is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x0c18c162.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function overhead() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackOverhead() []byte {
+	enc, err := gasPriceOracle.abi.Pack("overhead")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackOverhead is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x0c18c162.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function overhead() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackOverhead() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("overhead")
+}
+
+// UnpackOverhead is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x0c18c162.
+//
+// Solidity: function overhead() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackOverhead(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("overhead", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackScalar is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf45e65d8.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function scalar() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackScalar() []byte {
+	enc, err := gasPriceOracle.abi.Pack("scalar")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackScalar is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf45e65d8.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function scalar() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackScalar() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("scalar")
+}
+
+// UnpackScalar is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xf45e65d8.
+//
+// Solidity: function scalar() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackScalar(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("scalar", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackSetOperator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb3ab15fb.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setOperator(address _operator) returns()
+func (gasPriceOracle *GasPriceOracle) PackSetOperator(operator common.Address) []byte {
+	enc, err := gasPriceOracle.abi.Pack("setOperator", operator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetOperator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb3ab15fb.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setOperator(address _operator) returns()
+func (gasPriceOracle *GasPriceOracle) TryPackSetOperator(operator common.Address) ([]byte, error) {
+	return gasPriceOracle.abi.Pack("setOperator", operator)
+}
+
+// PackSetTokenRatio is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe38e91f9.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setTokenRatio(uint256 _tokenRatio) returns()
+func (gasPriceOracle *GasPriceOracle) PackSetTokenRatio(tokenRatio *big.Int) []byte {
+	enc, err := gasPriceOracle.abi.Pack("setTokenRatio", tokenRatio)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetTokenRatio is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe38e91f9.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setTokenRatio(uint256 _tokenRatio) returns()
+func (gasPriceOracle *GasPriceOracle) TryPackSetTokenRatio(tokenRatio *big.Int) ([]byte, error) {
+	return gasPriceOracle.abi.Pack("setTokenRatio", tokenRatio)
+}
+
+// PackTokenRatio is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x06f837d3.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function tokenRatio() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) PackTokenRatio() []byte {
+	enc, err := gasPriceOracle.abi.Pack("tokenRatio")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTokenRatio is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x06f837d3.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function tokenRatio() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) TryPackTokenRatio() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("tokenRatio")
+}
+
+// UnpackTokenRatio is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x06f837d3.
+//
+// Solidity: function tokenRatio() view returns(uint256)
+func (gasPriceOracle *GasPriceOracle) UnpackTokenRatio(data []byte) (*big.Int, error) {
+	out, err := gasPriceOracle.abi.Unpack("tokenRatio", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackVersion is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x54fd4d50.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function version() view returns(string)
+func (gasPriceOracle *GasPriceOracle) PackVersion() []byte {
+	enc, err := gasPriceOracle.abi.Pack("version")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackVersion is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x54fd4d50.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function version() view returns(string)
+func (gasPriceOracle *GasPriceOracle) TryPackVersion() ([]byte, error) {
+	return gasPriceOracle.abi.Pack("version")
+}
+
+// UnpackVersion is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (gasPriceOracle *GasPriceOracle) UnpackVersion(data []byte) (string, error) {
+	out, err := gasPriceOracle.abi.Unpack("version", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// GasPriceOracleOperatorUpdated represents a OperatorUpdated event raised by the GasPriceOracle contract.
+type GasPriceOracleOperatorUpdated struct {
+	PreviousOperator common.Address
+	NewOperator      common.Address
+	Raw              *types.Log // Blockchain specific contextual infos
+}
+
+const GasPriceOracleOperatorUpdatedEventName = "OperatorUpdated"
+
+// ContractEventName returns the user-defined event name.
+func (GasPriceOracleOperatorUpdated) ContractEventName() string {
+	return GasPriceOracleOperatorUpdatedEventName
+}
+
+// UnpackOperatorUpdatedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event OperatorUpdated(address previousOperator, address newOperator)
+func (gasPriceOracle *GasPriceOracle) UnpackOperatorUpdatedEvent(log *types.Log) (*GasPriceOracleOperatorUpdated, error) {
+	event := "OperatorUpdated"
+	if len(log.Topics) == 0 || log.Topics[0] != gasPriceOracle.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(GasPriceOracleOperatorUpdated)
+	if len(log.Data) > 0 {
+		if err := gasPriceOracle.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range gasPriceOracle.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// GasPriceOracleTokenRatioUpdated represents a TokenRatioUpdated event raised by the GasPriceOracle contract.
+type GasPriceOracleTokenRatioUpdated struct {
+	PreviousTokenRatio *big.Int
+	NewTokenRatio      *big.Int
+	Raw                *types.Log // Blockchain specific contextual infos
+}
+
+const GasPriceOracleTokenRatioUpdatedEventName = "TokenRatioUpdated"
+
+// ContractEventName returns the user-defined event name.
+func (GasPriceOracleTokenRatioUpdated) ContractEventName() string {
+	return GasPriceOracleTokenRatioUpdatedEventName
+}
+
+// UnpackTokenRatioUpdatedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event TokenRatioUpdated(uint256 previousTokenRatio, uint256 newTokenRatio)
+func (gasPriceOracle *GasPriceOracle) UnpackTokenRatioUpdatedEvent(log *types.Log) (*GasPriceOracleTokenRatioUpdated, error) {
+	event := "TokenRatioUpdated"
+	if len(log.Topics) == 0 || log.Topics[0] != gasPriceOracle.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(GasPriceOracleTokenRatioUpdated)
+	if len(log.Data) > 0 {
+		if err := gasPriceOracle.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range gasPriceOracle.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// L1BlockMetaData contains all meta data concerning the L1Block contract.
+var L1BlockMetaData = bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"DEPOSITOR_ACCOUNT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"basefee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"batcherHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"hash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l1FeeOverhead\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l1FeeScalar\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"number\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sequenceNumber\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"_number\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"_timestamp\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"_basefee\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"_hash\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"_sequenceNumber\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"_batcherHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_l1FeeOverhead\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_l1FeeScalar\",\"type\":\"uint256\"}],\"name\":\"setL1BlockValues\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"timestamp\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ID:  "0b379979e119b01ae6242b600c49955fe5",
+}
+
+// L1Block is an auto generated Go binding around an Ethereum contract.
+type L1Block struct {
+	abi abi.ABI
+}
+
+// NewL1Block creates a new instance of L1Block.
+func NewL1Block() *L1Block {
+	parsed, err := L1BlockMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &L1Block{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *L1Block) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackDEPOSITORACCOUNT is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe591b282.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function DEPOSITOR_ACCOUNT() view returns(address)
+func (l1Block *L1Block) PackDEPOSITORACCOUNT() []byte {
+	enc, err := l1Block.abi.Pack("DEPOSITOR_ACCOUNT")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackDEPOSITORACCOUNT is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe591b282.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function DEPOSITOR_ACCOUNT() view returns(address)
+func (l1Block *L1Block) TryPackDEPOSITORACCOUNT() ([]byte, error) {
+	return l1Block.abi.Pack("DEPOSITOR_ACCOUNT")
+}
+
+// UnpackDEPOSITORACCOUNT is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xe591b282.
+//
+// Solidity: function DEPOSITOR_ACCOUNT() view returns(address)
+func (l1Block *L1Block) UnpackDEPOSITORACCOUNT(data []byte) (common.Address, error) {
+	out, err := l1Block.abi.Unpack("DEPOSITOR_ACCOUNT", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackBasefee is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5cf24969.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function basefee() view returns(uint256)
+func (l1Block *L1Block) PackBasefee() []byte {
+	enc, err := l1Block.abi.Pack("basefee")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackBasefee is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5cf24969.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function basefee() view returns(uint256)
+func (l1Block *L1Block) TryPackBasefee() ([]byte, error) {
+	return l1Block.abi.Pack("basefee")
+}
+
+// UnpackBasefee is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x5cf24969.
+//
+// Solidity: function basefee() view returns(uint256)
+func (l1Block *L1Block) UnpackBasefee(data []byte) (*big.Int, error) {
+	out, err := l1Block.abi.Unpack("basefee", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackBatcherHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe81b2c6d.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function batcherHash() view returns(bytes32)
+func (l1Block *L1Block) PackBatcherHash() []byte {
+	enc, err := l1Block.abi.Pack("batcherHash")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackBatcherHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe81b2c6d.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function batcherHash() view returns(bytes32)
+func (l1Block *L1Block) TryPackBatcherHash() ([]byte, error) {
+	return l1Block.abi.Pack("batcherHash")
+}
+
+// UnpackBatcherHash is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xe81b2c6d.
+//
+// Solidity: function batcherHash() view returns(bytes32)
+func (l1Block *L1Block) UnpackBatcherHash(data []byte) ([32]byte, error) {
+	out, err := l1Block.abi.Unpack("batcherHash", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, nil
+}
+
+// PackHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x09bd5a60.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function hash() view returns(bytes32)
+func (l1Block *L1Block) PackHash() []byte {
+	enc, err := l1Block.abi.Pack("hash")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x09bd5a60.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function hash() view returns(bytes32)
+func (l1Block *L1Block) TryPackHash() ([]byte, error) {
+	return l1Block.abi.Pack("hash")
+}
+
+// UnpackHash is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x09bd5a60.
+//
+// Solidity: function hash() view returns(bytes32)
+func (l1Block *L1Block) UnpackHash(data []byte) ([32]byte, error) {
+	out, err := l1Block.abi.Unpack("hash", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, nil
+}
+
+// PackL1FeeOverhead is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8b239f73.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function l1FeeOverhead() view returns(uint256)
+func (l1Block *L1Block) PackL1FeeOverhead() []byte {
+	enc, err := l1Block.abi.Pack("l1FeeOverhead")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackL1FeeOverhead is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8b239f73.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function l1FeeOverhead() view returns(uint256)
+func (l1Block *L1Block) TryPackL1FeeOverhead() ([]byte, error) {
+	return l1Block.abi.Pack("l1FeeOverhead")
+}
+
+// UnpackL1FeeOverhead is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8b239f73.
+//
+// Solidity: function l1FeeOverhead() view returns(uint256)
+func (l1Block *L1Block) UnpackL1FeeOverhead(data []byte) (*big.Int, error) {
+	out, err := l1Block.abi.Unpack("l1FeeOverhead", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackL1FeeScalar is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9e8c4966.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function l1FeeScalar() view returns(uint256)
+func (l1Block *L1Block) PackL1FeeScalar() []byte {
+	enc, err := l1Block.abi.Pack("l1FeeScalar")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackL1FeeScalar is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9e8c4966.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function l1FeeScalar() view returns(uint256)
+func (l1Block *L1Block) TryPackL1FeeScalar() ([]byte, error) {
+	return l1Block.abi.Pack("l1FeeScalar")
+}
+
+// UnpackL1FeeScalar is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x9e8c4966.
+//
+// Solidity: function l1FeeScalar() view returns(uint256)
+func (l1Block *L1Block) UnpackL1FeeScalar(data []byte) (*big.Int, error) {
+	out, err := l1Block.abi.Unpack("l1FeeScalar", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8381f58a.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function number() view returns(uint64)
+func (l1Block *L1Block) PackNumber() []byte {
+	enc, err := l1Block.abi.Pack("number")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8381f58a.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function number() view returns(uint64)
+func (l1Block *L1Block) TryPackNumber() ([]byte, error) {
+	return l1Block.abi.Pack("number")
+}
+
+// UnpackNumber is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8381f58a.
+//
+// Solidity: function number() view returns(uint64)
+func (l1Block *L1Block) UnpackNumber(data []byte) (uint64, error) {
+	out, err := l1Block.abi.Unpack("number", data)
+	if err != nil {
+		return *new(uint64), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	return out0, nil
+}
+
+// PackSequenceNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x64ca23ef.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function sequenceNumber() view returns(uint64)
+func (l1Block *L1Block) PackSequenceNumber() []byte {
+	enc, err := l1Block.abi.Pack("sequenceNumber")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSequenceNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x64ca23ef.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function sequenceNumber() view returns(uint64)
+func (l1Block *L1Block) TryPackSequenceNumber() ([]byte, error) {
+	return l1Block.abi.Pack("sequenceNumber")
+}
+
+// UnpackSequenceNumber is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x64ca23ef.
+//
+// Solidity: function sequenceNumber() view returns(uint64)
+func (l1Block *L1Block) UnpackSequenceNumber(data []byte) (uint64, error) {
+	out, err := l1Block.abi.Unpack("sequenceNumber", data)
+	if err != nil {
+		return *new(uint64), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	return out0, nil
+}
+
+// PackSetL1BlockValues is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x015d8eb9.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setL1BlockValues(uint64 _number, uint64 _timestamp, uint256 _basefee, bytes32 _hash, uint64 _sequenceNumber, bytes32 _batcherHash, uint256 _l1FeeOverhead, uint256 _l1FeeScalar) returns()
+func (l1Block *L1Block) PackSetL1BlockValues(number uint64, timestamp uint64, basefee *big.Int, hash [32]byte, sequenceNumber uint64, batcherHash [32]byte, l1FeeOverhead *big.Int, l1FeeScalar *big.Int) []byte {
+	enc, err := l1Block.abi.Pack("setL1BlockValues", number, timestamp, basefee, hash, sequenceNumber, batcherHash, l1FeeOverhead, l1FeeScalar)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetL1BlockValues is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x015d8eb9.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setL1BlockValues(uint64 _number, uint64 _timestamp, uint256 _basefee, bytes32 _hash, uint64 _sequenceNumber, bytes32 _batcherHash, uint256 _l1FeeOverhead, uint256 _l1FeeScalar) returns()
+func (l1Block *L1Block) TryPackSetL1BlockValues(number uint64, timestamp uint64, basefee *big.Int, hash [32]byte, sequenceNumber uint64, batcherHash [32]byte, l1FeeOverhead *big.Int, l1FeeScalar *big.Int) ([]byte, error) {
+	return l1Block.abi.Pack("setL1BlockValues", number, timestamp, basefee, hash, sequenceNumber, batcherHash, l1FeeOverhead, l1FeeScalar)
+}
+
+// PackTimestamp is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb80777ea.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function timestamp() view returns(uint64)
+func (l1Block *L1Block) PackTimestamp() []byte {
+	enc, err := l1Block.abi.Pack("timestamp")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTimestamp is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb80777ea.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function timestamp() view returns(uint64)
+func (l1Block *L1Block) TryPackTimestamp() ([]byte, error) {
+	return l1Block.abi.Pack("timestamp")
+}
+
+// UnpackTimestamp is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xb80777ea.
+//
+// Solidity: function timestamp() view returns(uint64)
+func (l1Block *L1Block) UnpackTimestamp(data []byte) (uint64, error) {
+	out, err := l1Block.abi.Unpack("timestamp", data)
+	if err != nil {
+		return *new(uint64), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	return out0, nil
+}
+
+// PackVersion is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x54fd4d50.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function version() view returns(string)
+func (l1Block *L1Block) PackVersion() []byte {
+	enc, err := l1Block.abi.Pack("version")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackVersion is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x54fd4d50.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function version() view returns(string)
+func (l1Block *L1Block) TryPackVersion() ([]byte, error) {
+	return l1Block.abi.Pack("version")
+}
+
+// UnpackVersion is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (l1Block *L1Block) UnpackVersion(data []byte) (string, error) {
+	out, err := l1Block.abi.Unpack("version", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// L2StandardBridgeMetaData contains all meta data concerning the L2StandardBridge contract.
+var L2StandardBridgeMetaData = bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"l1Token\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"l2Token\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"extraData\",\"type\":\"bytes\"}],\"name\":\"DepositFinalized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"l1Token\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"l2Token\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"extraData\",\"type\":\"bytes\"}],\"name\":\"WithdrawalInitiated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"l1TokenBridge\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messenger\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_l2Token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"_minGasLimit\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"_extraData\",\"type\":\"bytes\"}],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_l2Token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"_minGasLimit\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"_extraData\",\"type\":\"bytes\"}],\"name\":\"withdrawTo\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+	ID:  "3e624185c5b995ee8deba3645cdf6992e6",
+}
+
+// L2StandardBridge is an auto generated Go binding around an Ethereum contract.
+type L2StandardBridge struct {
+	abi abi.ABI
+}
+
+// NewL2StandardBridge creates a new instance of L2StandardBridge.
+func NewL2StandardBridge() *L2StandardBridge {
+	parsed, err := L2StandardBridgeMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &L2StandardBridge{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *L2StandardBridge) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackL1TokenBridge is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x36c717c1.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function l1TokenBridge() view returns(address)
+func (l2StandardBridge *L2StandardBridge) PackL1TokenBridge() []byte {
+	enc, err := l2StandardBridge.abi.Pack("l1TokenBridge")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackL1TokenBridge is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x36c717c1.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function l1TokenBridge() view returns(address)
+func (l2StandardBridge *L2StandardBridge) TryPackL1TokenBridge() ([]byte, error) {
+	return l2StandardBridge.abi.Pack("l1TokenBridge")
+}
+
+// UnpackL1TokenBridge is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x36c717c1.
+//
+// Solidity: function l1TokenBridge() view returns(address)
+func (l2StandardBridge *L2StandardBridge) UnpackL1TokenBridge(data []byte) (common.Address, error) {
+	out, err := l2StandardBridge.abi.Unpack("l1TokenBridge", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackMessenger is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3cb747bf.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function messenger() view returns(address)
+func (l2StandardBridge *L2StandardBridge) PackMessenger() []byte {
+	enc, err := l2StandardBridge.abi.Pack("messenger")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackMessenger is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3cb747bf.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function messenger() view returns(address)
+func (l2StandardBridge *L2StandardBridge) TryPackMessenger() ([]byte, error) {
+	return l2StandardBridge.abi.Pack("messenger")
+}
+
+// UnpackMessenger is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x3cb747bf.
+//
+// Solidity: function messenger() view returns(address)
+func (l2StandardBridge *L2StandardBridge) UnpackMessenger(data []byte) (common.Address, error) {
+	out, err := l2StandardBridge.abi.Unpack("messenger", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackVersion is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x54fd4d50.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function version() view returns(string)
+func (l2StandardBridge *L2StandardBridge) PackVersion() []byte {
+	enc, err := l2StandardBridge.abi.Pack("version")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackVersion is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x54fd4d50.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function version() view returns(string)
+func (l2StandardBridge *L2StandardBridge) TryPackVersion() ([]byte, error) {
+	return l2StandardBridge.abi.Pack("version")
+}
+
+// UnpackVersion is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (l2StandardBridge *L2StandardBridge) UnpackVersion(data []byte) (string, error) {
+	out, err := l2StandardBridge.abi.Unpack("version", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackWithdraw is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x32b7006d.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function withdraw(address _l2Token, uint256 _amount, uint32 _minGasLimit, bytes _extraData) payable returns()
+func (l2StandardBridge *L2StandardBridge) PackWithdraw(l2Token common.Address, amount *big.Int, minGasLimit uint32, extraData []byte) []byte {
+	enc, err := l2StandardBridge.abi.Pack("withdraw", l2Token, amount, minGasLimit, extraData)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackWithdraw is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x32b7006d.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function withdraw(address _l2Token, uint256 _amount, uint32 _minGasLimit, bytes _extraData) payable returns()
+func (l2StandardBridge *L2StandardBridge) TryPackWithdraw(l2Token common.Address, amount *big.Int, minGasLimit uint32, extraData []byte) ([]byte, error) {
+	return l2StandardBridge.abi.Pack("withdraw", l2Token, amount, minGasLimit, extraData)
+}
+
+// PackWithdrawTo is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa3a79548.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function withdrawTo(address _l2Token, address _to, uint256 _amount, uint32 _minGasLimit, bytes _extraData) payable returns()
+func (l2StandardBridge *L2StandardBridge) PackWithdrawTo(l2Token common.Address, to common.Address, amount *big.Int, minGasLimit uint32, extraData []byte) []byte {
+	enc, err := l2StandardBridge.abi.Pack("withdrawTo", l2Token, to, amount, minGasLimit, extraData)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackWithdrawTo is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa3a79548.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function withdrawTo(address _l2Token, address _to, uint256 _amount, uint32 _minGasLimit, bytes _extraData) payable returns()
+func (l2StandardBridge *L2StandardBridge) TryPackWithdrawTo(l2Token common.Address, to common.Address, amount *big.Int, minGasLimit uint32, extraData []byte) ([]byte, error) {
+	return l2StandardBridge.abi.Pack("withdrawTo", l2Token, to, amount, minGasLimit, extraData)
+}
+
+// L2StandardBridgeDepositFinalized represents a DepositFinalized event raised by the L2StandardBridge contract.
+type L2StandardBridgeDepositFinalized struct {
+	L1Token   common.Address
+	L2Token   common.Address
+	From      common.Address
+	To        common.Address
+	Amount    *big.Int
+	ExtraData []byte
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const L2StandardBridgeDepositFinalizedEventName = "DepositFinalized"
+
+// ContractEventName returns the user-defined event name.
+func (L2StandardBridgeDepositFinalized) ContractEventName() string {
+	return L2StandardBridgeDepositFinalizedEventName
+}
+
+// UnpackDepositFinalizedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event DepositFinalized(address indexed l1Token, address indexed l2Token, address indexed from, address to, uint256 amount, bytes extraData)
+func (l2StandardBridge *L2StandardBridge) UnpackDepositFinalizedEvent(log *types.Log) (*L2StandardBridgeDepositFinalized, error) {
+	event := "DepositFinalized"
+	if len(log.Topics) == 0 || log.Topics[0] != l2StandardBridge.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(L2StandardBridgeDepositFinalized)
+	if len(log.Data) > 0 {
+		if err := l2StandardBridge.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range l2StandardBridge.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// L2StandardBridgeWithdrawalInitiated represents a WithdrawalInitiated event raised by the L2StandardBridge contract.
+type L2StandardBridgeWithdrawalInitiated struct {
+	L1Token   common.Address
+	L2Token   common.Address
+	From      common.Address
+	To        common.Address
+	Amount    *big.Int
+	ExtraData []byte
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const L2StandardBridgeWithdrawalInitiatedEventName = "WithdrawalInitiated"
+
+// ContractEventName returns the user-defined event name.
+func (L2StandardBridgeWithdrawalInitiated) ContractEventName() string {
+	return L2StandardBridgeWithdrawalInitiatedEventName
+}
+
+// UnpackWithdrawalInitiatedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event WithdrawalInitiated(address indexed l1Token, address indexed l2Token, address indexed from, address to, uint256 amount, bytes extraData)
+func (l2StandardBridge *L2StandardBridge) UnpackWithdrawalInitiatedEvent(log *types.Log) (*L2StandardBridgeWithdrawalInitiated, error) {
+	event := "WithdrawalInitiated"
+	if len(log.Topics) == 0 || log.Topics[0] != l2StandardBridge.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(L2StandardBridgeWithdrawalInitiated)
+	if len(log.Data) > 0 {
+		if err := l2StandardBridge.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range l2StandardBridge.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// L2ToL1MessagePasserMetaData contains all meta data concerning the L2ToL1MessagePasser contract.
+var L2ToL1MessagePasserMetaData = bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"mntValue\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"ethValue\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasLimit\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"withdrawalHash\",\"type\":\"bytes32\"}],\"name\":\"MessagePassed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"WithdrawerBalanceBurnt\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MESSAGE_VERSION\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_ethValue\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_target\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"initiateWithdrawal\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"sentMessages\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ID:  "5aa70195d5ef4e6a278b37300a5eb0dc71",
+}
+
+// L2ToL1MessagePasser is an auto generated Go binding around an Ethereum contract.
+type L2ToL1MessagePasser struct {
+	abi abi.ABI
+}
+
+// NewL2ToL1MessagePasser creates a new instance of L2ToL1MessagePasser.
+func NewL2ToL1MessagePasser() *L2ToL1MessagePasser {
+	parsed, err := L2ToL1MessagePasserMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &L2ToL1MessagePasser{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *L2ToL1MessagePasser) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackMESSAGEVERSION is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3f827a5a.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function MESSAGE_VERSION() view returns(uint16)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) PackMESSAGEVERSION() []byte {
+	enc, err := l2ToL1MessagePasser.abi.Pack("MESSAGE_VERSION")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackMESSAGEVERSION is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3f827a5a.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function MESSAGE_VERSION() view returns(uint16)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) TryPackMESSAGEVERSION() ([]byte, error) {
+	return l2ToL1MessagePasser.abi.Pack("MESSAGE_VERSION")
+}
+
+// UnpackMESSAGEVERSION is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x3f827a5a.
+//
+// Solidity: function MESSAGE_VERSION() view returns(uint16)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) UnpackMESSAGEVERSION(data []byte) (uint16, error) {
+	out, err := l2ToL1MessagePasser.abi.Unpack("MESSAGE_VERSION", data)
+	if err != nil {
+		return *new(uint16), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+	return out0, nil
+}
+
+// PackBurn is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x44df8e70.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function burn() returns()
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) PackBurn() []byte {
+	enc, err := l2ToL1MessagePasser.abi.Pack("burn")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackBurn is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x44df8e70.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function burn() returns()
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) TryPackBurn() ([]byte, error) {
+	return l2ToL1MessagePasser.abi.Pack("burn")
+}
+
+// PackInitiateWithdrawal is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x39fd0090.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function initiateWithdrawal(uint256 _ethValue, address _target, uint256 _gasLimit, bytes _data) payable returns()
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) PackInitiateWithdrawal(ethValue *big.Int, target common.Address, gasLimit *big.Int, data []byte) []byte {
+	enc, err := l2ToL1MessagePasser.abi.Pack("initiateWithdrawal", ethValue, target, gasLimit, data)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackInitiateWithdrawal is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x39fd0090.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function initiateWithdrawal(uint256 _ethValue, address _target, uint256 _gasLimit, bytes _data) payable returns()
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) TryPackInitiateWithdrawal(ethValue *big.Int, target common.Address, gasLimit *big.Int, data []byte) ([]byte, error) {
+	return l2ToL1MessagePasser.abi.Pack("initiateWithdrawal", ethValue, target, gasLimit, data)
+}
+
+// PackMessageNonce is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xecc70428.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function messageNonce() view returns(uint256)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) PackMessageNonce() []byte {
+	enc, err := l2ToL1MessagePasser.abi.Pack("messageNonce")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackMessageNonce is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xecc70428.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function messageNonce() view returns(uint256)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) TryPackMessageNonce() ([]byte, error) {
+	return l2ToL1MessagePasser.abi.Pack("messageNonce")
+}
+
+// UnpackMessageNonce is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xecc70428.
+//
+// Solidity: function messageNonce() view returns(uint256)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) UnpackMessageNonce(data []byte) (*big.Int, error) {
+	out, err := l2ToL1MessagePasser.abi.Unpack("messageNonce", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackSentMessages is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x82e3702d.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function sentMessages(bytes32 ) view returns(bool)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) PackSentMessages(arg0 [32]byte) []byte {
+	enc, err := l2ToL1MessagePasser.abi.Pack("sentMessages", arg0)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSentMessages is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x82e3702d.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function sentMessages(bytes32 ) view returns(bool)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) TryPackSentMessages(arg0 [32]byte) ([]byte, error) {
+	return l2ToL1MessagePasser.abi.Pack("sentMessages", arg0)
+}
+
+// UnpackSentMessages is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x82e3702d.
+//
+// Solidity: function sentMessages(bytes32 ) view returns(bool)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) UnpackSentMessages(data []byte) (bool, error) {
+	out, err := l2ToL1MessagePasser.abi.Unpack("sentMessages", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, nil
+}
+
+// PackVersion is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x54fd4d50.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function version() view returns(string)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) PackVersion() []byte {
+	enc, err := l2ToL1MessagePasser.abi.Pack("version")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackVersion is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x54fd4d50.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function version() view returns(string)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) TryPackVersion() ([]byte, error) {
+	return l2ToL1MessagePasser.abi.Pack("version")
+}
+
+// UnpackVersion is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) UnpackVersion(data []byte) (string, error) {
+	out, err := l2ToL1MessagePasser.abi.Unpack("version", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// L2ToL1MessagePasserMessagePassed represents a MessagePassed event raised by the L2ToL1MessagePasser contract.
+type L2ToL1MessagePasserMessagePassed struct {
+	Nonce          *big.Int
+	Sender         common.Address
+	Target         common.Address
+	MntValue       *big.Int
+	EthValue       *big.Int
+	GasLimit       *big.Int
+	Data           []byte
+	WithdrawalHash [32]byte
+	Raw            *types.Log // Blockchain specific contextual infos
+}
+
+const L2ToL1MessagePasserMessagePassedEventName = "MessagePassed"
+
+// ContractEventName returns the user-defined event name.
+func (L2ToL1MessagePasserMessagePassed) ContractEventName() string {
+	return L2ToL1MessagePasserMessagePassedEventName
+}
+
+// UnpackMessagePassedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event MessagePassed(uint256 indexed nonce, address indexed sender, address indexed target, uint256 mntValue, uint256 ethValue, uint256 gasLimit, bytes data, bytes32 withdrawalHash)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) UnpackMessagePassedEvent(log *types.Log) (*L2ToL1MessagePasserMessagePassed, error) {
+	event := "MessagePassed"
+	if len(log.Topics) == 0 || log.Topics[0] != l2ToL1MessagePasser.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(L2ToL1MessagePasserMessagePassed)
+	if len(log.Data) > 0 {
+		if err := l2ToL1MessagePasser.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range l2ToL1MessagePasser.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// L2ToL1MessagePasserWithdrawerBalanceBurnt represents a WithdrawerBalanceBurnt event raised by the L2ToL1MessagePasser contract.
+type L2ToL1MessagePasserWithdrawerBalanceBurnt struct {
+	Amount *big.Int
+	Raw    *types.Log // Blockchain specific contextual infos
+}
+
+const L2ToL1MessagePasserWithdrawerBalanceBurntEventName = "WithdrawerBalanceBurnt"
+
+// ContractEventName returns the user-defined event name.
+func (L2ToL1MessagePasserWithdrawerBalanceBurnt) ContractEventName() string {
+	return L2ToL1MessagePasserWithdrawerBalanceBurntEventName
+}
+
+// UnpackWithdrawerBalanceBurntEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event WithdrawerBalanceBurnt(uint256 indexed amount)
+func (l2ToL1MessagePasser *L2ToL1MessagePasser) UnpackWithdrawerBalanceBurntEvent(log *types.Log) (*L2ToL1MessagePasserWithdrawerBalanceBurnt, error) {
+	event := "WithdrawerBalanceBurnt"
+	if len(log.Topics) == 0 || log.Topics[0] != l2ToL1MessagePasser.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(L2ToL1MessagePasserWithdrawerBalanceBurnt)
+	if len(log.Data) > 0 {
+		if err := l2ToL1MessagePasser.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range l2ToL1MessagePasser.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
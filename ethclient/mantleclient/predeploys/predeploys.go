@@ -0,0 +1,69 @@
+// Package predeploys provides Go bindings for the Mantle L2 predeploys, along
+// with helpers to estimate the L1 data fee off-chain.
+package predeploys
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen -v2 -combined-json combined-abi.json -pkg predeploys -out bindings.go
+
+// Addresses of the Mantle L2 predeploys.
+var (
+	L1BlockAddr             = types.L1BlockAddr
+	GasPriceOracleAddr      = types.GasOracleAddr
+	BVMETHAddr              = common.HexToAddress("0xdEAddEaDdeadDEadDEADDEAddEADDEAddead1111")
+	L2StandardBridgeAddr    = common.HexToAddress("0x4200000000000000000000000000000000000010")
+	L2ToL1MessagePasserAddr = common.HexToAddress("0x4200000000000000000000000000000000000016")
+)
+
+// ReadL1FeeParams reads the L1 fee parameters from the L1Block and
+// GasPriceOracle predeploys. These are the values the node charges the L1 fee
+// with, the GasPriceOracle getters of the base fee, overhead and scalar merely
+// proxying the L1Block ones.
+func ReadL1FeeParams(backend bind.ContractBackend, opts *bind.CallOpts) (*types.L1FeeParams, error) {
+	var (
+		l1Block   = NewL1Block()
+		gasOracle = NewGasPriceOracle()
+
+		l1BlockInstance   = l1Block.Instance(backend, L1BlockAddr)
+		gasOracleInstance = gasOracle.Instance(backend, GasPriceOracleAddr)
+
+		fees types.L1FeeParams
+		err  error
+	)
+	if fees.L1BaseFee, err = bind.Call(l1BlockInstance, opts, l1Block.PackBasefee(), l1Block.UnpackBasefee); err != nil {
+		return nil, err
+	}
+	if fees.Overhead, err = bind.Call(l1BlockInstance, opts, l1Block.PackL1FeeOverhead(), l1Block.UnpackL1FeeOverhead); err != nil {
+		return nil, err
+	}
+	if fees.Scalar, err = bind.Call(l1BlockInstance, opts, l1Block.PackL1FeeScalar(), l1Block.UnpackL1FeeScalar); err != nil {
+		return nil, err
+	}
+	if fees.TokenRatio, err = bind.Call(gasOracleInstance, opts, gasOracle.PackTokenRatio(), gasOracle.UnpackTokenRatio); err != nil {
+		return nil, err
+	}
+	return &fees, nil
+}
+
+// EstimateL1Fee returns the L1 fee, in MNT wei, charged to the transaction if
+// included in a block with the given timestamp. Deposits are not charged.
+func EstimateL1Fee(fees *types.L1FeeParams, config *params.ChainConfig, time uint64, tx *types.Transaction) *big.Int {
+	if tx.IsDepositTx() {
+		return new(big.Int)
+	}
+	dataGas := tx.RollupCostData().DataGas(time, config)
+	return types.L1Cost(dataGas, fees.L1BaseFee, fees.Overhead, fees.Scalar, fees.TokenRatio)
+}
+
+// BVMETHBalance returns the BVM_ETH balance of the given account.
+func BVMETHBalance(backend bind.ContractBackend, opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	bvmETH := NewBVMETH()
+	return bind.Call(bvmETH.Instance(backend, BVMETHAddr), opts, bvmETH.PackBalanceOf(account), bvmETH.UnpackBalanceOf)
+}
@@ -0,0 +1,118 @@
+package predeploys
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	testKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	testAddr   = crypto.PubkeyToAddress(testKey.PublicKey)
+)
+
+func TestL1FeeEstimation(t *testing.T) {
+	sim := simulated.NewBackend(types.GenesisAlloc{
+		testAddr:           {Balance: big.NewInt(params.Ether)},
+		GasPriceOracleAddr: {Storage: map[common.Hash]common.Hash{types.TokenRatioSlot: common.BigToHash(big.NewInt(2))}},
+	}, simulated.WithRollup())
+	defer sim.Close()
+
+	client := sim.Client()
+	head, _ := client.HeaderByNumber(context.Background(), nil)
+	chainid, _ := client.ChainID(context.Background())
+
+	tx := types.MustSignNewTx(testKey, types.LatestSignerForChainID(chainid), &types.DynamicFeeTx{
+		ChainID:   chainid,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: new(big.Int).Add(head.BaseFee, big.NewInt(params.GWei)),
+		Gas:       200_000,
+		To:        &common.Address{0xff},
+		Data:      []byte{0x00, 0x01, 0x02, 0x03},
+	})
+	if err := client.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("could not send transaction: %v", err)
+	}
+	hash := sim.Commit()
+
+	block, err := client.BlockByHash(context.Background(), hash)
+	if err != nil {
+		t.Fatalf("failed to retrieve block: %v", err)
+	}
+	receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve receipt: %v", err)
+	}
+	fees, err := ReadL1FeeParams(client, &bind.CallOpts{BlockHash: hash})
+	if err != nil {
+		t.Fatalf("failed to read L1 fee parameters: %v", err)
+	}
+	if fees.TokenRatio.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("token ratio mismatch: have %v, want 2", fees.TokenRatio)
+	}
+	if fees.L1BaseFee.Cmp(receipt.L1GasPrice) != 0 {
+		t.Errorf("L1 base fee mismatch: have %v, want %v", fees.L1BaseFee, receipt.L1GasPrice)
+	}
+	regolith := &params.ChainConfig{RegolithTime: new(uint64)}
+	if have := EstimateL1Fee(fees, regolith, block.Time(), tx); have.Sign() == 0 || have.Cmp(receipt.L1Fee) != 0 {
+		t.Errorf("L1 fee mismatch: have %v, want %v", have, receipt.L1Fee)
+	}
+	if have := EstimateL1Fee(fees, regolith, block.Time(), block.Transactions()[0]); have.Sign() != 0 {
+		t.Errorf("deposit charged L1 fee %v", have)
+	}
+}
+
+func TestBVMETHBalance(t *testing.T) {
+	// The balances of BVM_ETH live in the mapping at slot 0
+	key := crypto.Keccak256Hash(common.LeftPadBytes(testAddr.Bytes(), 32), common.LeftPadBytes(nil, 32))
+
+	sim := simulated.NewBackend(types.GenesisAlloc{
+		BVMETHAddr: {Storage: map[common.Hash]common.Hash{key: common.BigToHash(big.NewInt(params.Ether))}},
+	}, simulated.WithRollup())
+	defer sim.Close()
+
+	balance, err := BVMETHBalance(sim.Client(), nil, testAddr)
+	if err != nil {
+		t.Fatalf("failed to read BVM_ETH balance: %v", err)
+	}
+	if balance.Cmp(big.NewInt(params.Ether)) != 0 {
+		t.Errorf("balance mismatch: have %v, want %v", balance, params.Ether)
+	}
+	if balance, err = BVMETHBalance(sim.Client(), nil, common.Address{1}); err != nil || balance.Sign() != 0 {
+		t.Errorf("unexpected balance of empty account: %v, %v", balance, err)
+	}
+}
+
+// Tests that the bindings match the interfaces of the deployed predeploys.
+func TestBindingSelectors(t *testing.T) {
+	tests := []struct {
+		packed    []byte
+		signature string
+	}{
+		{NewL1Block().PackSetL1BlockValues(0, 0, new(big.Int), common.Hash{}, 0, common.Hash{}, new(big.Int), new(big.Int)), "setL1BlockValues(uint64,uint64,uint256,bytes32,uint64,bytes32,uint256,uint256)"},
+		{NewGasPriceOracle().PackSetTokenRatio(new(big.Int)), "setTokenRatio(uint256)"},
+		{NewGasPriceOracle().PackGetL1Fee(nil), "getL1Fee(bytes)"},
+		{NewBVMETH().PackBalanceOf(common.Address{}), "balanceOf(address)"},
+		{NewL2StandardBridge().PackWithdraw(common.Address{}, new(big.Int), 0, nil), "withdraw(address,uint256,uint32,bytes)"},
+		{NewL2StandardBridge().PackWithdrawTo(common.Address{}, common.Address{}, new(big.Int), 0, nil), "withdrawTo(address,address,uint256,uint32,bytes)"},
+		{NewL2ToL1MessagePasser().PackInitiateWithdrawal(new(big.Int), common.Address{}, new(big.Int), nil), "initiateWithdrawal(uint256,address,uint256,bytes)"},
+	}
+	for _, tt := range tests {
+		if want := crypto.Keccak256([]byte(tt.signature))[:4]; !bytes.Equal(tt.packed[:4], want) {
+			t.Errorf("%s: selector mismatch: have %x, want %x", tt.signature, tt.packed[:4], want)
+		}
+	}
+	// The BVM_ETH mint event is emitted by the state transition
+	mint := NewBVMETH().abi.Events["Mint"].ID
+	if want := common.HexToHash("0x0f6798a560793a54c3bcfe86a93cde1e73087d944c0ea20544137d4121396885"); mint != want {
+		t.Errorf("mint event mismatch: have %x, want %x", mint, want)
+	}
+}
@@ -145,6 +145,11 @@ func newWithNode(stack *node.Node, conf *eth.Config, blockPeriod uint64) (*Backe
 	if err != nil {
 		return nil, err
 	}
+	if conf.SimulatedRollup {
+		if err := beacon.EnableRollup(new(catalyst.RollupDevConfig)); err != nil {
+			return nil, err
+		}
+	}
 	// Reorg our chain back to genesis
 	if err := beacon.Fork(backend.BlockChain().GetCanonicalHash(0)); err != nil {
 		return nil, err
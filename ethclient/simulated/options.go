@@ -19,6 +19,9 @@ package simulated
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/node"
 )
@@ -53,3 +56,43 @@ func WithMinerMinTip(tip *big.Int) func(nodeConf *node.Config, ethConf *ethconfi
 		ethConf.Miner.GasPrice = tip
 	}
 }
+
+// WithRollup configures the simulated backend to run a Mantle rollup chain with
+// all Mantle upgrades active. Every block starts with an L1 attributes deposit,
+// and the L1Block, GasPriceOracle and BVM_ETH predeploys are replaced with
+// minimal stand-ins holding the L1 fee parameters and BVM_ETH balances.
+//
+// Genesis accounts of the predeploys keep the stand-in code, which allows to
+// seed their storage, e.g. with BVM_ETH balances.
+func WithRollup() func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+	return func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+		ethConf.SimulatedRollup = true
+
+		genesis := core.DeveloperRollupGenesisBlock(ethConf.Genesis.GasLimit, nil)
+		genesis.Config.ChainID = ethConf.Genesis.Config.ChainID
+
+		ethConf.Genesis.Config = genesis.Config
+		if ethConf.Genesis.Alloc == nil {
+			ethConf.Genesis.Alloc = make(types.GenesisAlloc)
+		}
+		for addr, account := range genesis.Alloc {
+			seeded, ok := ethConf.Genesis.Alloc[addr]
+			if !ok {
+				ethConf.Genesis.Alloc[addr] = account
+				continue
+			}
+			if len(seeded.Code) == 0 && len(account.Code) != 0 {
+				seeded.Code = account.Code
+				for key, value := range account.Storage {
+					if _, ok := seeded.Storage[key]; !ok {
+						if seeded.Storage == nil {
+							seeded.Storage = make(map[common.Hash]common.Hash)
+						}
+						seeded.Storage[key] = value
+					}
+				}
+				ethConf.Genesis.Alloc[addr] = seeded
+			}
+		}
+	}
+}
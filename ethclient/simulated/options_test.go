@@ -25,6 +25,9 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient/mantleclient"
+	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -72,3 +75,79 @@ func TestWithCallGasLimitOption(t *testing.T) {
 		t.Fatalf("error mismatch: have %v, want %v", err, core.ErrIntrinsicGas)
 	}
 }
+
+// Tests that the simulator builds rollup blocks with the rollup option, and that
+// the receipts report the L1 fee derived from the on-chain fee parameters.
+func TestWithRollupOption(t *testing.T) {
+	sim := NewBackend(types.GenesisAlloc{
+		testAddr: {Balance: big.NewInt(10000000000000000)},
+	}, WithRollup())
+	defer sim.Close()
+
+	client := sim.Client()
+	head, _ := client.HeaderByNumber(context.Background(), nil)
+	chainid, _ := client.ChainID(context.Background())
+
+	// Leave room for the L1 fee, charged in gas
+	tx := types.MustSignNewTx(testKey, types.LatestSignerForChainID(chainid), &types.DynamicFeeTx{
+		ChainID:   chainid,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: new(big.Int).Add(head.BaseFee, big.NewInt(params.GWei)),
+		Gas:       100_000,
+		To:        &testAddr2,
+	})
+	if err := client.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("could not send transaction: %v", err)
+	}
+	hash := sim.Commit()
+
+	block, err := client.BlockByHash(context.Background(), hash)
+	if err != nil {
+		t.Fatalf("failed to retrieve head block: %v", err)
+	}
+	if txs := block.Transactions(); len(txs) != 2 || !txs[0].IsDepositTx() || txs[1].Hash() != tx.Hash() {
+		t.Fatalf("block does not start with the L1 attributes deposit")
+	}
+	mc := mantleclient.New(sim.node.Attach())
+	receipt, err := mc.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve receipt: %v", err)
+	}
+	info, err := mc.L1FeeInfo(context.Background(), hash)
+	if err != nil {
+		t.Fatalf("failed to retrieve L1 fee info: %v", err)
+	}
+	if receipt.L1GasPrice.Cmp(info.L1BaseFee) != 0 {
+		t.Errorf("L1 gas price mismatch: have %v, want %v", receipt.L1GasPrice, info.L1BaseFee)
+	}
+	if receipt.TokenRatio.Cmp(info.TokenRatio) != 0 {
+		t.Errorf("token ratio mismatch: have %v, want %v", receipt.TokenRatio, info.TokenRatio)
+	}
+	if receipt.FeeScalar.Cmp(info.FeeScalar) != 0 {
+		t.Errorf("fee scalar mismatch: have %v, want %v", receipt.FeeScalar, info.FeeScalar)
+	}
+	regolith := &params.ChainConfig{RegolithTime: new(uint64)}
+	want := info.L1Cost(tx.RollupCostData().DataGas(block.Time(), regolith))
+	if receipt.L1Fee.Sign() == 0 || receipt.L1Fee.Cmp(want) != 0 {
+		t.Errorf("L1 fee mismatch: have %v, want %v", receipt.L1Fee, want)
+	}
+}
+
+// Tests that a rollup chain configuration alone does not make the simulator
+// build blocks like the Mantle sequencer.
+func TestRollupConfigWithoutOption(t *testing.T) {
+	sim := NewBackend(types.GenesisAlloc{
+		testAddr: {Balance: big.NewInt(10000000000000000)},
+	}, func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+		ethConf.Genesis.Config = core.DeveloperRollupGenesisBlock(ethConf.Genesis.GasLimit, nil).Config
+	})
+	defer sim.Close()
+
+	block, err := sim.Client().BlockByHash(context.Background(), sim.Commit())
+	if err != nil {
+		t.Fatalf("failed to retrieve head block: %v", err)
+	}
+	if txs := block.Transactions(); len(txs) != 0 {
+		t.Fatalf("block has %d transactions, want none", len(txs))
+	}
+}
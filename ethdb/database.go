@@ -206,12 +206,16 @@ type AncientStore interface {
 	io.Closer
 }
 
-// ResettableAncientStore extends the AncientStore interface by adding a Reset method.
+// ResettableAncientStore extends the AncientStore interface by adding the Reset methods.
 type ResettableAncientStore interface {
 	AncientStore
 
 	// Reset is designed to reset the entire ancient store to its default state.
 	Reset() error
+
+	// ResetTo resets the entire ancient store to an empty state in which the
+	// first item is positioned at the given number.
+	ResetTo(tail uint64) error
 }
 
 // Database contains all the methods required by the high level database to not
@@ -209,6 +209,23 @@ func (w *Withdrawal) Amount(ctx context.Context) hexutil.Uint64 {
 	return hexutil.Uint64(w.amount)
 }
 
+// MetaTx represents the gas fee sponsorship of a Mantle meta transaction.
+type MetaTx struct {
+	params *types.MetaTxParams
+}
+
+func (m *MetaTx) Sponsor(ctx context.Context) common.Address {
+	return m.params.GasFeeSponsor
+}
+
+func (m *MetaTx) SponsorPercent(ctx context.Context) Long {
+	return Long(m.params.SponsorPercent)
+}
+
+func (m *MetaTx) ExpireHeight(ctx context.Context) Long {
+	return Long(m.params.ExpireHeight)
+}
+
 // Transaction represents an Ethereum transaction.
 // backend and hash are mandatory; all others will be fetched when required.
 type Transaction struct {
@@ -359,6 +376,152 @@ func (t *Transaction) BlobVersionedHashes(ctx context.Context) *[]common.Hash {
 	return &blobHashes
 }
 
+func (t *Transaction) SourceHash(ctx context.Context) *common.Hash {
+	tx, _ := t.resolve(ctx)
+	if tx == nil || !tx.IsDepositTx() {
+		return nil
+	}
+	hash := tx.SourceHash()
+	return &hash
+}
+
+func (t *Transaction) Mint(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	return nonZeroBig(tx.Mint())
+}
+
+func (t *Transaction) EthValue(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	return nonZeroBig(tx.ETHValue())
+}
+
+func (t *Transaction) EthTxValue(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	return nonZeroBig(tx.ETHTxValue())
+}
+
+// nonZeroBig returns the deposit value as reported by the resolvers, null if
+// the deposit does not carry it. Missing values may decode as zero.
+func nonZeroBig(v *big.Int) *hexutil.Big {
+	if v == nil || v.Sign() == 0 {
+		return nil
+	}
+	return (*hexutil.Big)(v)
+}
+
+func (t *Transaction) IsSystemTx(ctx context.Context) *bool {
+	tx, _ := t.resolve(ctx)
+	if tx == nil || !tx.IsDepositTx() {
+		return nil
+	}
+	ret := tx.IsSystemTx()
+	return &ret
+}
+
+// getRollupReceiptFields returns the rollup specific receipt fields of the
+// transaction, as reported by eth_getTransactionReceipt.
+func (t *Transaction) getRollupReceiptFields(ctx context.Context) (*ethapi.RollupReceiptFields, error) {
+	tx, _ := t.resolve(ctx)
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	fields := ethapi.NewRollupReceiptFields(receipt, tx, t.r.backend.ChainConfig())
+	return &fields, nil
+}
+
+func (t *Transaction) DepositNonce(ctx context.Context) (*Long, error) {
+	fields, err := t.getRollupReceiptFields(ctx)
+	if err != nil || fields == nil || fields.DepositNonce == nil {
+		return nil, err
+	}
+	ret := Long(*fields.DepositNonce)
+	return &ret, nil
+}
+
+// getL1Fee returns the L1 data fee charged to the transaction.
+func (t *Transaction) getL1Fee(ctx context.Context) (*ethapi.ReceiptL1Fee, error) {
+	fields, err := t.getRollupReceiptFields(ctx)
+	if err != nil || fields == nil {
+		return nil, err
+	}
+	return fields.L1, nil
+}
+
+func (t *Transaction) L1Fee(ctx context.Context) (*hexutil.Big, error) {
+	l1, err := t.getL1Fee(ctx)
+	if err != nil || l1 == nil {
+		return nil, err
+	}
+	return l1.Fee, nil
+}
+
+func (t *Transaction) L1GasUsed(ctx context.Context) (*hexutil.Big, error) {
+	l1, err := t.getL1Fee(ctx)
+	if err != nil || l1 == nil {
+		return nil, err
+	}
+	return l1.GasUsed, nil
+}
+
+func (t *Transaction) L1GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	l1, err := t.getL1Fee(ctx)
+	if err != nil || l1 == nil {
+		return nil, err
+	}
+	return l1.GasPrice, nil
+}
+
+func (t *Transaction) FeeScalar(ctx context.Context) (*string, error) {
+	l1, err := t.getL1Fee(ctx)
+	if err != nil || l1 == nil {
+		return nil, err
+	}
+	return &l1.FeeScalar, nil
+}
+
+func (t *Transaction) TokenRatio(ctx context.Context) (*hexutil.Big, error) {
+	l1, err := t.getL1Fee(ctx)
+	if err != nil || l1 == nil {
+		return nil, err
+	}
+	return l1.TokenRatio, nil
+}
+
+func (t *Transaction) MetaTx(ctx context.Context) (*MetaTx, error) {
+	tx, block := t.resolve(ctx)
+	if tx == nil {
+		return nil, nil
+	}
+	// Meta transaction rules depend on the forks active at the inclusion
+	// of the transaction, or at the head for pending ones.
+	var header *types.Header
+	if block != nil {
+		h, err := block.resolveHeader(ctx)
+		if err != nil {
+			return nil, err
+		}
+		header = h
+	} else {
+		header = t.r.backend.CurrentHeader()
+	}
+	config := t.r.backend.ChainConfig()
+	metaTxParams, err := types.DecodeAndVerifyMetaTxParams(tx, config.IsMetaTxV2(header.Time), config.IsMetaTxV3(header.Time), config.IsMantleEverest(header.Time))
+	if err != nil || metaTxParams == nil {
+		return nil, err
+	}
+	return &MetaTx{params: metaTxParams}, nil
+}
+
 func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
 	tx, block := t.resolve(ctx)
 	if tx == nil {
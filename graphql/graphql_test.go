@@ -38,6 +38,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/miner"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -430,6 +431,80 @@ func TestWithdrawals(t *testing.T) {
 	}
 }
 
+func TestGraphQLMantleTransactionFields(t *testing.T) {
+	var (
+		// The L1 fee depends on the signature, the key is fixed.
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		genesis = core.DeveloperRollupGenesisBlock(11500000, &addr)
+		signer  = types.LatestSigner(genesis.Config)
+		stack   = createNode(t)
+
+		sourceHash = common.HexToHash("0x5ce")
+		depositor  = common.HexToAddress("0xde9")
+	)
+	defer stack.Close()
+
+	var deposit, tx *types.Transaction
+	handler, _ := newGQLService(t, stack, false, genesis, 1, func(i int, gen *core.BlockGen) {
+		gen.SetParentBeaconRoot(common.Hash{1})
+		deposit = types.NewTx(&types.DepositTx{
+			SourceHash: sourceHash,
+			From:       depositor,
+			To:         &depositor,
+			Mint:       big.NewInt(params.GWei),
+			Value:      common.Big0,
+			Gas:        100000,
+			EthValue:   big.NewInt(params.GWei),
+		})
+		gen.AddTx(deposit)
+		tx, _ = types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   genesis.Config.ChainID,
+			To:        &depositor,
+			Gas:       1000000,
+			GasFeeCap: gen.BaseFee(),
+			GasTipCap: common.Big0,
+			Data:      []byte{0x01, 0x00, 0x02},
+		})
+		gen.AddTx(tx)
+	})
+	// start node
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+	const (
+		depositFields = "sourceHash mint ethValue ethTxValue isSystemTx depositNonce"
+		l1FeeFields   = "l1Fee l1GasUsed l1GasPrice feeScalar tokenRatio"
+	)
+	for i, tt := range []struct {
+		body string
+		want string
+	}{
+		// Deposits report their source and minted value, but no L1 fee.
+		{
+			body: fmt.Sprintf(`{ transaction(hash: "%s") { %s %s metaTx { sponsor } } }`, deposit.Hash(), depositFields, l1FeeFields),
+			want: fmt.Sprintf(`{"transaction":{"sourceHash":"%s","mint":"0x3b9aca00","ethValue":"0x3b9aca00","ethTxValue":null,"isSystemTx":false,"depositNonce":0,"l1Fee":null,"l1GasUsed":null,"l1GasPrice":null,"feeScalar":null,"tokenRatio":null,"metaTx":null}}`, sourceHash),
+		},
+		// Regular transactions report the L1 fee they were charged, but no deposit fields.
+		{
+			body: fmt.Sprintf(`{ transaction(hash: "%s") { %s %s metaTx { sponsor } } }`, tx.Hash(), depositFields, l1FeeFields),
+			want: `{"transaction":{"sourceHash":null,"mint":null,"ethValue":null,"ethTxValue":null,"isSystemTx":null,"depositNonce":null,"l1Fee":"0x1128ec03400","l1GasUsed":"0x6bc","l1GasPrice":"0x3b9aca00","feeScalar":"0.684","tokenRatio":"0x1","metaTx":null}}`,
+		},
+	} {
+		res := handler.Schema.Exec(context.Background(), tt.body, "", map[string]interface{}{})
+		if res.Errors != nil {
+			t.Fatalf("failed to execute query for testcase #%d: %v", i, res.Errors)
+		}
+		have, err := json.Marshal(res.Data)
+		if err != nil {
+			t.Fatalf("failed to encode graphql response for testcase #%d: %s", i, err)
+		}
+		if string(have) != tt.want {
+			t.Errorf("response unmatch for testcase #%d.\nhave:\n%s\nwant:\n%s", i, have, tt.want)
+		}
+	}
+}
+
 // TestGraphQLMaxDepth ensures that queries exceeding the configured maximum depth
 // are rejected to prevent resource exhaustion from deeply nested operations.
 func TestGraphQLMaxDepth(t *testing.T) {
@@ -488,6 +563,7 @@ func newGQLService(t *testing.T, stack *node.Node, shanghai bool, gspec *core.Ge
 		SnapshotCache:  5,
 		RPCGasCap:      1000000,
 		StateScheme:    rawdb.HashScheme,
+		Miner:          miner.DefaultConfig,
 	}
 	var engine = beacon.New(ethash.NewFaker())
 	if shanghai {
@@ -504,7 +580,7 @@ func newGQLService(t *testing.T, stack *node.Node, shanghai bool, gspec *core.Ge
 		t.Fatalf("could not create eth backend: %v", err)
 	}
 	// Create some blocks and import them
-	chain, _ := core.GenerateChain(params.AllEthashProtocolChanges, ethBackend.BlockChain().Genesis(),
+	chain, _ := core.GenerateChain(gspec.Config, ethBackend.BlockChain().Genesis(),
 		engine, ethBackend.ChainDb(), genBlocks, genfunc)
 	_, err = ethBackend.BlockChain().InsertChain(chain)
 	if err != nil {
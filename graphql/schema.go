@@ -170,6 +170,52 @@ const schema string = `
         rawReceipt: Bytes!
         # BlobVersionedHashes is a set of hash outputs from the blobs in the transaction.
         blobVersionedHashes: [Bytes32!]
+
+        # SourceHash uniquely identifies the origin of a deposit transaction.
+        # This is null for other transactions.
+        sourceHash: Bytes32
+        # Mint is the amount of MNT, in wei, minted on L2 by a deposit
+        # transaction. This is null if the deposit mints no MNT.
+        mint: BigInt
+        # EthValue is the amount of BVM_ETH, in wei, minted on L2 by a deposit
+        # transaction. This is null if the deposit mints no BVM_ETH.
+        ethValue: BigInt
+        # EthTxValue is the amount of BVM_ETH, in wei, transferred to the
+        # recipient of a deposit transaction. This is null if the deposit
+        # transfers no BVM_ETH.
+        ethTxValue: BigInt
+        # IsSystemTx is true for system deposit transactions, and null for other
+        # transactions.
+        isSystemTx: Boolean
+        # DepositNonce is the nonce of the sender of a deposit transaction. This
+        # is null for other transactions, or if the deposit has not yet been mined.
+        depositNonce: Long
+        # L1Fee is the fee, in wei, paid for the data availability of the
+        # transaction on L1. This is null for deposit transactions, or if the
+        # transaction has not yet been mined.
+        l1Fee: BigInt
+        # L1GasUsed is the amount of L1 gas the transaction data was charged for.
+        l1GasUsed: BigInt
+        # L1GasPrice is the L1 base fee the L1 fee was computed with, in wei.
+        l1GasPrice: BigInt
+        # FeeScalar is the scalar applied to the L1 fee, as a decimal number.
+        feeScalar: String
+        # TokenRatio is the MNT/ETH price ratio the gas of the transaction was
+        # scaled with.
+        tokenRatio: BigInt
+        # MetaTx is the gas fee sponsorship of a meta transaction. This is null
+        # for other transactions.
+        metaTx: MetaTx
+    }
+
+    # MetaTx is the gas fee sponsorship of a Mantle meta transaction.
+    type MetaTx {
+        # Sponsor is the account paying for the sponsored part of the gas fee.
+        sponsor: Address!
+        # SponsorPercent is the percentage of the gas fee paid by the sponsor.
+        sponsorPercent: Long!
+        # ExpireHeight is the block number after which the sponsorship expires.
+        expireHeight: Long!
     }
 
     # BlockFilterCriteria encapsulates log filter criteria for a filter applied
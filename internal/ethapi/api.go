@@ -49,7 +49,6 @@ import (
 	"github.com/ethereum/go-ethereum/preconf"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
-	"github.com/ethereum/go-ethereum/trie"
 )
 
 var (
@@ -73,6 +72,11 @@ func NewEthereumAPI(b Backend) *EthereumAPI {
 }
 
 // GasPrice returns a suggestion for a gas price for legacy transactions.
+//
+// The L1 fee parameters, including their values predicted for the pending
+// block, have no bearing on the price per gas: the token ratio scales the gas
+// used by a transaction and the L1 fee is charged as additional gas. They are
+// reflected by the gas limit returned from eth_estimateGas instead.
 func (api *EthereumAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
 	tipcap, err := api.b.SuggestGasTipCap(ctx)
 	if err != nil {
@@ -331,24 +335,6 @@ func (api *BlockChainAPI) BlockNumber() hexutil.Uint64 {
 // given block number. The rpc.LatestBlockNumber and rpc.PendingBlockNumber meta
 // block numbers are also allowed.
 func (api *BlockChainAPI) GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
-	header, err := headerByNumberOrHash(ctx, api.b, blockNrOrHash)
-	if err != nil {
-		return nil, err
-	}
-
-	if api.b.ChainConfig().IsOptimismPreBedrock(header.Number) {
-		if api.b.HistoricalRPCService() != nil {
-			var res hexutil.Big
-			err := api.b.HistoricalRPCService().CallContext(ctx, &res, "eth_getBalance", address, blockNrOrHash)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return &res, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
 	if state == nil || err != nil {
 		return nil, err
@@ -389,22 +375,6 @@ func (n *proofList) Delete(key []byte) error {
 
 // GetProof returns the Merkle-proof for a given account and optionally some storage keys.
 func (api *BlockChainAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*AccountResult, error) {
-	header, err := headerByNumberOrHash(ctx, api.b, blockNrOrHash)
-	if err != nil {
-		return nil, err
-	}
-	if api.b.ChainConfig().IsOptimismPreBedrock(header.Number) {
-		if api.b.HistoricalRPCService() != nil {
-			var res AccountResult
-			err := api.b.HistoricalRPCService().CallContext(ctx, &res, "eth_getProof", address, storageKeys, blockNrOrHash)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return &res, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
 	var (
 		keys         = make([]common.Hash, len(storageKeys))
 		keyLengths   = make([]int, len(storageKeys))
@@ -425,11 +395,16 @@ func (api *BlockChainAPI) GetProof(ctx context.Context, address common.Address,
 	codeHash := statedb.GetCodeHash(address)
 	storageRoot := statedb.GetStorageRoot(address)
 
+	// Open the tries through the state database, the historical tries are
+	// resolved from the trienode histories if the state is no longer live.
+	tr, err := statedb.Database().OpenTrie(header.Root)
+	if err != nil {
+		return nil, err
+	}
 	if len(keys) > 0 {
 		var storageTrie state.Trie
 		if storageRoot != types.EmptyRootHash && storageRoot != (common.Hash{}) {
-			id := trie.StorageTrieID(header.Root, crypto.Keccak256Hash(address.Bytes()), storageRoot)
-			st, err := trie.NewStateTrie(id, statedb.Database().TrieDB())
+			st, err := statedb.Database().OpenStorageTrie(header.Root, address, storageRoot, tr)
 			if err != nil {
 				return nil, err
 			}
@@ -460,10 +435,6 @@ func (api *BlockChainAPI) GetProof(ctx context.Context, address common.Address,
 		}
 	}
 	// Create the accountProof.
-	tr, err := trie.NewStateTrie(trie.StateTrieID(header.Root), statedb.Database().TrieDB())
-	if err != nil {
-		return nil, err
-	}
 	var accountProof proofList
 	if err := tr.Prove(crypto.Keccak256(address.Bytes()), &accountProof); err != nil {
 		return nil, err
@@ -538,6 +509,9 @@ func (api *BlockChainAPI) GetHeaderByHash(ctx context.Context, hash common.Hash)
 func (api *BlockChainAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
 	block, err := api.b.BlockByNumber(ctx, number)
 	if block != nil && err == nil {
+		if number >= 0 {
+			MarkFinalizedResponse(ctx, api.b, block.NumberU64())
+		}
 		response := RPCMarshalBlock(ctx, block, true, fullTx, api.b.ChainConfig(), api.b)
 		if number == rpc.PendingBlockNumber && api.b.ChainConfig().Optimism == nil {
 			// Pending blocks need to nil out a few fields
@@ -555,6 +529,7 @@ func (api *BlockChainAPI) GetBlockByNumber(ctx context.Context, number rpc.Block
 func (api *BlockChainAPI) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]interface{}, error) {
 	block, err := api.b.BlockByHash(ctx, hash)
 	if block != nil {
+		MarkFinalizedResponse(ctx, api.b, block.NumberU64())
 		return RPCMarshalBlock(ctx, block, true, fullTx, api.b.ChainConfig(), api.b), nil
 	}
 	return nil, err
@@ -612,24 +587,6 @@ func (api *BlockChainAPI) GetUncleCountByBlockHash(ctx context.Context, blockHas
 
 // GetCode returns the code stored at the given address in the state for the given block number.
 func (api *BlockChainAPI) GetCode(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
-	header, err := headerByNumberOrHash(ctx, api.b, blockNrOrHash)
-	if err != nil {
-		return nil, err
-	}
-
-	if api.b.ChainConfig().IsOptimismPreBedrock(header.Number) {
-		if api.b.HistoricalRPCService() != nil {
-			var res hexutil.Bytes
-			err := api.b.HistoricalRPCService().CallContext(ctx, &res, "eth_getCode", address, blockNrOrHash)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return res, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
 	if state == nil || err != nil {
 		return nil, err
@@ -642,24 +599,6 @@ func (api *BlockChainAPI) GetCode(ctx context.Context, address common.Address, b
 // block number. The rpc.LatestBlockNumber and rpc.PendingBlockNumber meta block
 // numbers are also allowed.
 func (api *BlockChainAPI) GetStorageAt(ctx context.Context, address common.Address, hexKey string, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
-	header, err := headerByNumberOrHash(ctx, api.b, blockNrOrHash)
-	if err != nil {
-		return nil, err
-	}
-
-	if api.b.ChainConfig().IsOptimismPreBedrock(header.Number) {
-		if api.b.HistoricalRPCService() != nil {
-			var res hexutil.Bytes
-			err := api.b.HistoricalRPCService().CallContext(ctx, &res, "eth_getStorageAt", address, hexKey, blockNrOrHash)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return res, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
 	if state == nil || err != nil {
 		return nil, err
@@ -693,6 +632,9 @@ func (api *BlockChainAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rp
 		if err != nil {
 			return nil, err
 		}
+		if blockNr, ok := blockNrOrHash.Number(); !ok || blockNr >= 0 {
+			MarkFinalizedResponse(ctx, api.b, block.NumberU64())
+		}
 	}
 	txs := block.Transactions()
 	if len(txs) != len(receipts) {
@@ -708,36 +650,21 @@ func (api *BlockChainAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rp
 	return result, nil
 }
 
-func (api *BlockChainAPI) GetBlockRange(ctx context.Context, startNumber rpc.BlockNumber, endNumber rpc.BlockNumber, fullTx bool) ([]map[string]interface{}, error) {
-	// Basic assertions about start and end block numbers.
-	if endNumber < startNumber {
-		return nil, fmt.Errorf("start of block range (%d) is greater than end of block range (%d)", startNumber, endNumber)
+// MarkFinalizedResponse marks the response of the call as immutable if the
+// given block is finalized, allowing the RPC server to cache it. Callers must
+// only use this if the response does not depend on the chain head, i.e. the
+// block was not requested by a tag.
+func MarkFinalizedResponse(ctx context.Context, b interface {
+	HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error)
+}, number uint64) {
+	if !rpc.ResponseCacheEnabled(ctx) {
+		return
 	}
-
-	// Assert that the number of blocks is < 1k (? configurable?).
-	if endNumber-startNumber > 1000 {
-		return nil, fmt.Errorf("requested block range is too large (max is 1000, requested %d blocks)", endNumber-startNumber)
-	}
-
-	// Make sure the end exists. If start doesn't exist, will be caught immediately below.
-	if _, err := api.GetBlockByNumber(ctx, endNumber, fullTx); err != nil {
-		return nil, fmt.Errorf("end of requested block range (%d) does not exist: %w", endNumber, err)
+	finalized, err := b.HeaderByNumber(ctx, rpc.FinalizedBlockNumber)
+	if err != nil || finalized == nil || number > finalized.Number.Uint64() {
+		return
 	}
-
-	// Create an empty output array.
-	blocks := make([]map[string]interface{}, 0)
-	// For each block in range, get block and append to array.
-	for number := startNumber; number <= endNumber; number++ {
-		block, err := api.GetBlockByNumber(ctx, number, fullTx)
-		if err != nil {
-			return nil, err
-		}
-		if block == nil {
-			return nil, errors.New("block in range not indexed, this should never happen")
-		}
-		blocks = append(blocks, block)
-	}
-	return blocks, nil
+	rpc.MarkResponseImmutable(ctx)
 }
 
 // The HeaderByNumberOrHash method returns a nil error and nil header
@@ -908,24 +835,6 @@ func (api *BlockChainAPI) Call(ctx context.Context, args TransactionArgs, blockN
 		blockNrOrHash = &latest
 	}
 
-	header, err := headerByNumberOrHash(ctx, api.b, *blockNrOrHash)
-	if err != nil {
-		return nil, err
-	}
-
-	if api.b.ChainConfig().IsOptimismPreBedrock(header.Number) {
-		if api.b.HistoricalRPCService() != nil {
-			var res hexutil.Bytes
-			err := api.b.HistoricalRPCService().CallContext(ctx, &res, "eth_call", args, blockNrOrHash, overrides)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return res, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	result, err := DoCall(ctx, api.b, args, *blockNrOrHash, overrides, blockOverrides, api.b.RPCEVMTimeout(), api.b.RPCGasCap(), core.EthcallMode)
 	if err != nil {
 		return nil, err
@@ -980,27 +889,38 @@ func (api *BlockChainAPI) SimulateV1(ctx context.Context, opts simOpts, blockNrO
 // there are unexpected failures. The gas limit is capped by both `args.Gas` (if non-nil &
 // non-zero) and `gasCap` (if non-zero).
 func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *override.StateOverride, blockOverrides *override.BlockOverrides, gasCap uint64) (hexutil.Uint64, error) {
+	estimate, err := doEstimateGas(ctx, b, args, blockNrOrHash, overrides, blockOverrides, gasCap, b.RPCTokenRatioMargin())
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(estimate.Gas * gasBuffer / 100), nil
+}
+
+// doEstimateGas runs the gas estimation of DoEstimateGas, reporting the parts the
+// estimated gas limit is made of. The token ratio is increased by the given
+// relative margin before scaling the execution gas.
+func doEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *override.StateOverride, blockOverrides *override.BlockOverrides, gasCap uint64, tokenRatioMargin float64) (*gasestimator.Estimation, error) {
 	// Retrieve the base state and mutate it with any overrides
 	state, header, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
 	if state == nil || err != nil {
-		return 0, err
+		return nil, err
 	}
 	blockCtx := core.NewEVMBlockContext(header, NewChainContext(ctx, b), nil, b.ChainConfig(), state)
 	if blockOverrides != nil {
 		if err := blockOverrides.Apply(&blockCtx); err != nil {
-			return 0, err
+			return nil, err
 		}
 	}
 	rules := b.ChainConfig().Rules(blockCtx.BlockNumber, blockCtx.Random != nil, blockCtx.Time)
 	precompiles := vm.ActivePrecompiledContracts(rules)
 	if err := overrides.Apply(state, precompiles); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// Normalize the gasPrice used for estimateGas
 	gasPriceForEstimate, err := b.SuggestGasTipCap(ctx)
 	if err != nil {
-		return 0, errors.New("failed to get suggest gas tip cap")
+		return nil, errors.New("failed to get suggest gas tip cap")
 	}
 	if header.BaseFee != nil {
 		gasPriceForEstimate.Add(gasPriceForEstimate, header.BaseFee)
@@ -1015,6 +935,8 @@ func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNr
 		State:                      state,
 		ErrorRatio:                 estimateGasErrorRatio,
 		DefaultGasPriceForEstimate: gasPriceForEstimate,
+		L1FeeParams:                estimateL1FeeParams(ctx, b, blockNrOrHash, overrides),
+		TokenRatioMargin:           tokenRatioMargin,
 	}
 	// Set any required transaction default, but make sure the gas cap itself is not messed with
 	// if it was not specified in the original argument list.
@@ -1024,7 +946,7 @@ func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNr
 
 	// disable meta tx
 	if err = types.MetaTxCheck(args.data()); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	runMode := core.GasEstimationMode
@@ -1034,19 +956,47 @@ func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNr
 		runMode = core.GasEstimationWithSkipCheckBalanceMode
 	}
 	if err := args.CallDefaults(gasCap, header.BaseFee, b.ChainConfig().ChainID); err != nil {
-		return 0, err
+		return nil, err
 	}
 	call := args.ToMessage(header.BaseFee, true, runMode, (*hexutil.Big)(gasPriceForEstimate))
 
 	// Run the gas estimation and wrap any revertals into a custom return
-	estimate, revert, err := gasestimator.Estimate(ctx, call, opts, gasCap)
+	estimate, revert, err := gasestimator.EstimateBreakdown(ctx, call, opts, gasCap)
 	if err != nil {
 		if errors.Is(err, vm.ErrExecutionReverted) {
-			return 0, newRevertError(revert)
+			return nil, newRevertError(revert)
 		}
-		return 0, err
+		return nil, err
+	}
+	return estimate, nil
+}
+
+// estimateL1FeeParams returns the fee oracle values a gas estimation at the given
+// block should be priced with. Estimates on top of the chain head are meant for
+// transactions included in the next block, so they are priced with the values of
+// the pending block, unless the oracles are overridden by the caller. A nil
+// result leaves the values found in the estimation state in effect.
+func estimateL1FeeParams(ctx context.Context, b Backend, blockNrOrHash rpc.BlockNumberOrHash, overrides *override.StateOverride) *types.L1FeeParams {
+	if !b.ChainConfig().IsOptimism() {
+		return nil
+	}
+	number, ok := blockNrOrHash.Number()
+	if !ok || (number != rpc.LatestBlockNumber && number != rpc.PendingBlockNumber) {
+		return nil
+	}
+	if overrides != nil {
+		if _, ok := (*overrides)[types.L1BlockAddr]; ok {
+			return nil
+		}
+		if _, ok := (*overrides)[types.GasOracleAddr]; ok {
+			return nil
+		}
+	}
+	fees, _, err := pendingL1FeeParams(ctx, b)
+	if err != nil {
+		return nil
 	}
-	return hexutil.Uint64(estimate * gasBuffer / 100), nil
+	return fees
 }
 
 // EstimateGas returns the lowest possible gas limit that allows the transaction to run
@@ -1061,24 +1011,6 @@ func (api *BlockChainAPI) EstimateGas(ctx context.Context, args TransactionArgs,
 		bNrOrHash = *blockNrOrHash
 	}
 
-	header, err := headerByNumberOrHash(ctx, api.b, bNrOrHash)
-	if err != nil {
-		return 0, err
-	}
-
-	if api.b.ChainConfig().IsOptimismPreBedrock(header.Number) {
-		if api.b.HistoricalRPCService() != nil {
-			var res hexutil.Uint64
-			err := api.b.HistoricalRPCService().CallContext(ctx, &res, "eth_estimateGas", args, blockNrOrHash)
-			if err != nil {
-				return 0, fmt.Errorf("historical backend error: %w", err)
-			}
-			return res, nil
-		} else {
-			return 0, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	return DoEstimateGas(ctx, api.b, args, bNrOrHash, overrides, blockOverrides, api.b.RPCGasCap())
 }
 
@@ -1385,20 +1317,6 @@ func (api *BlockChainAPI) CreateAccessList(ctx context.Context, args Transaction
 	if blockNrOrHash != nil {
 		bNrOrHash = *blockNrOrHash
 	}
-	header, err := headerByNumberOrHash(ctx, api.b, bNrOrHash)
-	if err == nil && header != nil && api.b.ChainConfig().IsOptimismPreBedrock(header.Number) {
-		if api.b.HistoricalRPCService() != nil {
-			var res accessListResult
-			err := api.b.HistoricalRPCService().CallContext(ctx, &res, "eth_createAccessList", args, blockNrOrHash)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return &res, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	acl, gasUsed, vmerr, err := AccessList(ctx, api.b, bNrOrHash, args, stateOverrides)
 	if err != nil {
 		return nil, err
@@ -1663,24 +1581,6 @@ func (api *TransactionAPI) GetTransactionCount(ctx context.Context, address comm
 		return (*hexutil.Uint64)(&nonce), nil
 	}
 
-	header, err := headerByNumberOrHash(ctx, api.b, blockNrOrHash)
-	if err != nil {
-		return nil, err
-	}
-
-	if api.b.ChainConfig().IsOptimismPreBedrock(header.Number) {
-		if api.b.HistoricalRPCService() != nil {
-			var res hexutil.Uint64
-			err := api.b.HistoricalRPCService().CallContext(ctx, &res, "eth_getTransactionCount", address, blockNrOrHash)
-			if err != nil {
-				return nil, fmt.Errorf("historical backend error: %w", err)
-			}
-			return &res, nil
-		} else {
-			return nil, rpc.ErrNoHistoricalFallback
-		}
-	}
-
 	// Resolve block number and use its state to ask for the nonce
 	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
 	if state == nil || err != nil {
@@ -1747,6 +1647,8 @@ func (api *TransactionAPI) GetTransactionReceipt(ctx context.Context, hash commo
 	if err != nil {
 		return nil, err
 	}
+	MarkFinalizedResponse(ctx, api.b, blockNumber)
+
 	// Derive the sender.
 	return MarshalReceipt(receipt, blockHash, blockNumber, api.signer, tx, int(index), api.b.ChainConfig()), nil
 }
@@ -1771,17 +1673,18 @@ func MarshalReceipt(receipt *types.Receipt, blockHash common.Hash, blockNumber u
 		"effectiveGasPrice": (*hexutil.Big)(receipt.EffectiveGasPrice),
 	}
 
-	if chainConfig.Optimism != nil && !tx.IsDepositTx() {
-		fields["l1GasPrice"] = (*hexutil.Big)(receipt.L1GasPrice)
-		fields["l1GasUsed"] = (*hexutil.Big)(receipt.L1GasUsed)
-		fields["l1Fee"] = (*hexutil.Big)(receipt.L1Fee)
-		fields["l1FeeScalar"] = receipt.FeeScalar.String()
-		if receipt.TokenRatio != nil {
-			fields["tokenRatio"] = (*hexutil.Big)(receipt.TokenRatio)
+	rollup := NewRollupReceiptFields(receipt, tx, chainConfig)
+	if l1 := rollup.L1; l1 != nil {
+		fields["l1GasPrice"] = l1.GasPrice
+		fields["l1GasUsed"] = l1.GasUsed
+		fields["l1Fee"] = l1.Fee
+		fields["l1FeeScalar"] = l1.FeeScalar
+		if l1.TokenRatio != nil {
+			fields["tokenRatio"] = l1.TokenRatio
 		}
 	}
-	if chainConfig.Optimism != nil && tx.IsDepositTx() && receipt.DepositNonce != nil {
-		fields["depositNonce"] = hexutil.Uint64(*receipt.DepositNonce)
+	if rollup.DepositNonce != nil {
+		fields["depositNonce"] = *rollup.DepositNonce
 	}
 
 	// Assign receipt status or post state.
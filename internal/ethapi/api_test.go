@@ -601,6 +601,9 @@ func (b testBackend) ExtRPCEnabled() bool                      { return false }
 func (b testBackend) RPCGasCap() uint64                        { return 10000000 }
 func (b testBackend) RPCEVMTimeout() time.Duration             { return time.Second }
 func (b testBackend) RPCTxFeeCap() float64                     { return 0 }
+func (b testBackend) RPCBlockRangeLimit() uint64               { return 0 }
+func (b testBackend) RPCBlockRangeConcurrency() int            { return 0 }
+func (b testBackend) RPCTokenRatioMargin() float64             { return 0 }
 func (b testBackend) UnprotectedAllowed() bool                 { return false }
 func (b testBackend) SetHead(number uint64)                    {}
 func (b testBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
@@ -610,6 +613,9 @@ func (b testBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber)
 	if number == rpc.PendingBlockNumber && b.pending != nil {
 		return b.pending.Header(), nil
 	}
+	if number == rpc.FinalizedBlockNumber {
+		return b.chain.CurrentFinalBlock(), nil
+	}
 	return b.chain.GetHeaderByNumber(uint64(number)), nil
 }
 func (b testBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
@@ -635,6 +641,13 @@ func (b testBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber)
 	if number == rpc.PendingBlockNumber {
 		return b.pending, nil
 	}
+	if number == rpc.FinalizedBlockNumber {
+		head := b.chain.CurrentFinalBlock()
+		if head == nil {
+			return nil, nil
+		}
+		return b.chain.GetBlock(head.Hash(), head.Number.Uint64()), nil
+	}
 	if number == rpc.EarliestBlockNumber {
 		number = 0
 	}
@@ -683,6 +696,7 @@ func (b testBackend) Pending() (*types.Block, types.Receipts, *state.StateDB) {
 	}
 	return block, b.pendingReceipts, nil
 }
+func (b testBackend) PendingL1FeeParams() *types.L1FeeParams { return nil }
 func (b testBackend) GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
 	header, err := b.HeaderByHash(ctx, hash)
 	if header == nil || err != nil {
@@ -759,9 +773,6 @@ func (b testBackend) NewMatcherBackend() filtermaps.MatcherBackend {
 	panic("implement me")
 }
 
-func (b testBackend) HistoricalRPCService() *rpc.Client {
-	panic("implement me")
-}
 func (b testBackend) Genesis() *types.Block {
 	panic("implement me")
 }
@@ -1060,6 +1071,149 @@ func TestEstimateGas(t *testing.T) {
 	}
 }
 
+// pendingStateBackend is a test backend serving the given state as the state of
+// the pending block.
+type pendingStateBackend struct {
+	*testBackend
+	pendingState *state.StateDB
+}
+
+func (b pendingStateBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*state.StateDB, *types.Header, error) {
+	if number == rpc.PendingBlockNumber {
+		return b.pendingState, b.pending.Header(), nil
+	}
+	return b.testBackend.StateAndHeaderByNumber(ctx, number)
+}
+
+func TestEstimateGasTokenRatio(t *testing.T) {
+	t.Parallel()
+
+	var (
+		accounts = newAccounts(1)
+		contract = common.Address{0xc0}
+		genesis  = core.DeveloperRollupGenesisBlock(30_000_000, nil)
+	)
+	genesis.Alloc[accounts[0].addr] = types.Account{Balance: big.NewInt(params.Ether)}
+	genesis.Alloc[contract] = types.Account{Code: common.FromHex("0x600160005500")} // sstore(0, 1)
+	oracle := genesis.Alloc[types.GasOracleAddr]
+	oracle.Storage = map[common.Hash]common.Hash{types.TokenRatioSlot: common.BigToHash(big.NewInt(2))}
+	genesis.Alloc[types.GasOracleAddr] = oracle
+
+	backend := newTestBackend(t, 1, genesis, beacon.New(ethash.NewFaker()), func(i int, b *core.BlockGen) {
+		b.SetParentBeaconRoot(common.Hash{byte(i + 1)})
+	})
+	// The pending block raises the token ratio.
+	pending, _, err := backend.StateAndHeaderByNumber(context.Background(), rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatalf("failed to retrieve state: %v", err)
+	}
+	pending.SetState(types.GasOracleAddr, types.TokenRatioSlot, common.BigToHash(big.NewInt(3)))
+	api := NewMantleAPI(pendingStateBackend{backend, pending})
+
+	var (
+		args   = TransactionArgs{From: &accounts[0].addr, To: &contract}
+		first  = rpc.BlockNumberOrHashWithNumber(1)
+		margin = 0.5
+	)
+	// Estimates at a given block are priced with the oracle values of the block.
+	have, err := api.EstimateGas(context.Background(), args, &first, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to estimate gas: %v", err)
+	}
+	if have.TokenRatio.ToInt().Uint64() != 2 {
+		t.Errorf("token ratio mismatch: have %v, want 2", have.TokenRatio)
+	}
+	if have.L1Gas == 0 {
+		t.Error("missing L1 gas")
+	}
+	if want := (uint64(have.ExecutionGas)*2 + uint64(have.L1Gas)) * gasBuffer / 100; uint64(have.Gas) != want {
+		t.Errorf("gas mismatch: have %d, want %d", have.Gas, want)
+	}
+	// Estimates on top of the head are priced with the pending oracle values,
+	// with the margin on top.
+	pendingEstimate, err := api.EstimateGas(context.Background(), args, nil, nil, nil, &margin)
+	if err != nil {
+		t.Fatalf("failed to estimate gas: %v", err)
+	}
+	if pendingEstimate.TokenRatio.ToInt().Uint64() != 5 {
+		t.Errorf("token ratio mismatch: have %v, want 5", pendingEstimate.TokenRatio)
+	}
+	if pendingEstimate.ExecutionGas != have.ExecutionGas {
+		t.Errorf("execution gas mismatch: have %d, want %d", pendingEstimate.ExecutionGas, have.ExecutionGas)
+	}
+	if pendingEstimate.L1Gas <= have.L1Gas {
+		t.Errorf("L1 gas not scaled by the token ratio: have %d, previously %d", pendingEstimate.L1Gas, have.L1Gas)
+	}
+	if want := (uint64(pendingEstimate.ExecutionGas)*5 + uint64(pendingEstimate.L1Gas)) * gasBuffer / 100; uint64(pendingEstimate.Gas) != want {
+		t.Errorf("gas mismatch: have %d, want %d", pendingEstimate.Gas, want)
+	}
+}
+
+// predictedFeesBackend is a test backend predicting the given fee oracle values
+// for the pending block.
+type predictedFeesBackend struct {
+	pendingStateBackend
+	fees *types.L1FeeParams
+}
+
+func (b predictedFeesBackend) PendingL1FeeParams() *types.L1FeeParams { return b.fees }
+
+func TestGetL1FeeParams(t *testing.T) {
+	t.Parallel()
+
+	genesis := core.DeveloperRollupGenesisBlock(30_000_000, nil)
+	oracle := genesis.Alloc[types.GasOracleAddr]
+	oracle.Storage = map[common.Hash]common.Hash{types.TokenRatioSlot: common.BigToHash(big.NewInt(2))}
+	genesis.Alloc[types.GasOracleAddr] = oracle
+
+	backend := newTestBackend(t, 1, genesis, beacon.New(ethash.NewFaker()), func(i int, b *core.BlockGen) {
+		b.SetParentBeaconRoot(common.Hash{byte(i + 1)})
+	})
+	pending, _, err := backend.StateAndHeaderByNumber(context.Background(), rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatalf("failed to retrieve state: %v", err)
+	}
+	pending.SetState(types.GasOracleAddr, types.TokenRatioSlot, common.BigToHash(big.NewInt(3)))
+
+	var (
+		latest    = rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		pendingNr = rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber)
+		predicted = &types.L1FeeParams{L1BaseFee: big.NewInt(7), Overhead: big.NewInt(8), Scalar: big.NewInt(9), TokenRatio: big.NewInt(4)}
+	)
+	for i, tt := range []struct {
+		fees          *types.L1FeeParams
+		block         rpc.BlockNumberOrHash
+		wantRatio     int64
+		wantPredicted bool
+	}{
+		{nil, latest, 2, false},
+		{nil, pendingNr, 3, false},    // falls back to the pending state
+		{predicted, latest, 2, false}, // predictions only apply to the pending block
+		{predicted, pendingNr, 4, true},
+	} {
+		api := NewMantleAPI(predictedFeesBackend{pendingStateBackend{backend, pending}, tt.fees})
+		have, err := api.GetL1FeeParams(context.Background(), tt.block)
+		if err != nil {
+			t.Fatalf("test %d: failed to get L1 fee params: %v", i, err)
+		}
+		if have.TokenRatio.ToInt().Int64() != tt.wantRatio || have.Predicted != tt.wantPredicted {
+			t.Errorf("test %d: have token ratio %v (predicted %v), want %d (predicted %v)", i, have.TokenRatio, have.Predicted, tt.wantRatio, tt.wantPredicted)
+		}
+		if tt.wantPredicted && have.L1BaseFee.ToInt().Cmp(predicted.L1BaseFee) != 0 {
+			t.Errorf("test %d: have L1 base fee %v, want %v", i, have.L1BaseFee, predicted.L1BaseFee)
+		}
+	}
+	// Gas estimates on top of the head are priced with the prediction.
+	api := NewMantleAPI(predictedFeesBackend{pendingStateBackend{backend, pending}, predicted})
+	estimate, err := api.EstimateGas(context.Background(), TransactionArgs{From: &common.Address{0x01}, To: &common.Address{0x02}}, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to estimate gas: %v", err)
+	}
+	if estimate.TokenRatio.ToInt().Int64() != 4 {
+		t.Errorf("estimate token ratio mismatch: have %v, want 4", estimate.TokenRatio)
+	}
+}
+
 func TestCall(t *testing.T) {
 	t.Parallel()
 
@@ -3709,6 +3863,75 @@ func TestRPCGetTransactionReceipt(t *testing.T) {
 	}
 }
 
+func TestRPCResponseCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend, txHashes = setupReceiptBackend(t, 6)
+		server            = rpc.NewServer()
+	)
+	server.SetResponseCache(rpc.NewResponseCache(1024 * 1024))
+	if err := server.RegisterName("eth", NewBlockChainAPI(backend)); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.RegisterName("eth", NewTransactionAPI(backend, new(AddrLocker))); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	// Finalize the blocks up to #3, the transaction at index i is in block #i+1.
+	backend.chain.SetFinalized(backend.chain.GetHeaderByNumber(3))
+	var (
+		finalized   = backend.chain.GetHeaderByNumber(3).Hash()
+		unfinalized = backend.chain.GetHeaderByNumber(4).Hash()
+	)
+	tests := []struct {
+		method string
+		args   []any
+		cached bool
+	}{
+		{"eth_getBlockByNumber", []any{hexutil.Uint64(3), false}, true},
+		{"eth_getBlockByNumber", []any{hexutil.Uint64(4), false}, false},
+		{"eth_getBlockByNumber", []any{"latest", false}, false},
+		{"eth_getBlockByNumber", []any{"finalized", false}, false},
+		{"eth_getBlockByHash", []any{finalized, false}, true},
+		{"eth_getBlockByHash", []any{unfinalized, false}, false},
+		{"eth_getBlockReceipts", []any{hexutil.Uint64(3)}, true},
+		{"eth_getBlockReceipts", []any{hexutil.Uint64(4)}, false},
+		{"eth_getBlockReceipts", []any{"latest"}, false},
+		{"eth_getBlockReceipts", []any{"finalized"}, false},
+		{"eth_getTransactionReceipt", []any{txHashes[2]}, true},
+		{"eth_getTransactionReceipt", []any{txHashes[3]}, false},
+	}
+	// The first immutable result of a method only enables caching it, call
+	// every method twice for the results to be cached.
+	results := make([]json.RawMessage, len(tests))
+	for i, test := range tests {
+		for range 2 {
+			if err := client.Call(&results[i], test.method, test.args...); err != nil {
+				t.Fatalf("test %d: %s failed: %v", i, test.method, err)
+			}
+		}
+		if string(results[i]) == "null" {
+			t.Fatalf("test %d: %s returned no result", i, test.method)
+		}
+	}
+	// Rewind the chain below the finalized block without purging the cache, so
+	// that only the cached responses are unchanged.
+	if err := backend.chain.SetHead(2); err != nil {
+		t.Fatalf("failed to rewind chain: %v", err)
+	}
+	for i, test := range tests {
+		var result json.RawMessage
+		err := client.Call(&result, test.method, test.args...)
+		if cached := err == nil && bytes.Equal(result, results[i]); cached != test.cached {
+			t.Errorf("test %d: %s %v: cached %v, want %v", i, test.method, test.args, cached, test.cached)
+		}
+	}
+}
+
 func TestRPCGetBlockReceipts(t *testing.T) {
 	t.Parallel()
 
@@ -4032,3 +4255,73 @@ func (b configTimeBackend) HeaderByNumber(_ context.Context, n rpc.BlockNumber)
 func (b configTimeBackend) CurrentHeader() *types.Header {
 	return &types.Header{Time: b.time}
 }
+
+func TestMantleConfig(t *testing.T) {
+	t.Parallel()
+
+	u64 := func(v uint64) *uint64 { return &v }
+	config := *params.OptimismTestConfig
+	config.BaseFeeTime = u64(0)
+	config.BVMETHMintUpgradeTime = u64(100)
+	config.MetaTxV2UpgradeTime = u64(150)
+	config.MetaTxV3UpgradeTime = u64(200)
+	config.MantleEverestTime = u64(300)
+	config.MantleSkadiTime = u64(300)
+	gspec := &core.Genesis{Config: &config, Timestamp: 100, Alloc: types.GenesisAlloc{}}
+
+	result, err := NewMantleAPI(configTimeBackend{nil, gspec, 250}).Config(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get config: %v", err)
+	}
+	// Forks active at genesis are reported at time 0, the unscheduled ones are
+	// left out and the forks at the same time keep their definition order.
+	want := []struct {
+		name   string
+		time   uint64
+		active bool
+		metaTx string
+	}{
+		{"baseFee", 0, true, "v1"},
+		{"bvmETHMint", 0, true, "v1"},
+		{"metaTxV2", 150, true, "v2"},
+		{"metaTxV3", 200, true, "v3"},
+		{"everest", 300, false, "disabled"},
+		{"skadi", 300, false, "disabled"},
+	}
+	if len(result.Forks) != len(want) {
+		t.Fatalf("wrong number of forks: have %d, want %d", len(result.Forks), len(want))
+	}
+	for i, fork := range result.Forks {
+		if fork.Name != want[i].name || fork.ActivationTime != want[i].time || fork.Active != want[i].active || fork.Features.MetaTx != want[i].metaTx {
+			t.Errorf("fork %d: have %s at %d (active %v, metaTx %s), want %s at %d (active %v, metaTx %s)", i,
+				fork.Name, fork.ActivationTime, fork.Active, fork.Features.MetaTx, want[i].name, want[i].time, want[i].active, want[i].metaTx)
+		}
+	}
+	if result.Current == nil || result.Current.Name != "metaTxV3" {
+		t.Errorf("wrong current fork: %v", result.Current)
+	}
+	if result.Next == nil || result.Next.Name != "everest" {
+		t.Errorf("wrong next fork: %v", result.Next)
+	}
+	// Advancing the head changes the current fork, but not the schedule hash.
+	later, err := NewMantleAPI(configTimeBackend{nil, gspec, 300}).Config(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get config: %v", err)
+	}
+	if later.Current == nil || later.Current.Name != "skadi" || later.Next != nil {
+		t.Errorf("wrong forks after advancing the head: current %v, next %v", later.Current, later.Next)
+	}
+	if later.ScheduleHash != result.ScheduleHash {
+		t.Errorf("schedule hash changed with the head: have %x, want %x", later.ScheduleHash, result.ScheduleHash)
+	}
+	// Rescheduling a fork changes the schedule hash.
+	rescheduled := config
+	rescheduled.MantleSkadiTime = u64(400)
+	other, err := NewMantleAPI(configTimeBackend{nil, &core.Genesis{Config: &rescheduled, Timestamp: 100, Alloc: types.GenesisAlloc{}}, 250}).Config(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get config: %v", err)
+	}
+	if other.ScheduleHash == result.ScheduleHash {
+		t.Errorf("schedule hash unchanged after rescheduling a fork")
+	}
+}
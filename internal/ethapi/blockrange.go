@@ -0,0 +1,216 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// defaultBlockRangeLimit is the maximum number of blocks following the
+	// first one returned by eth_getBlockRange if no limit is configured.
+	defaultBlockRangeLimit = 1000
+
+	// defaultBlockRangeConcurrency is the number of blocks fetched concurrently
+	// if no concurrency is configured.
+	defaultBlockRangeConcurrency = 8
+
+	// blockRangePageSize is the maximum number of blocks in a page of the
+	// blockRange subscription.
+	blockRangePageSize = 100
+)
+
+// blockRangePage is a notification of the blockRange subscription.
+type blockRangePage struct {
+	Blocks []map[string]interface{} `json:"blocks"`
+	Last   bool                     `json:"last"`            // Set on the last page of the range
+	Error  string                   `json:"error,omitempty"` // Set if the range could not be streamed to its end
+}
+
+// blockRangeLimits returns the maximum number of blocks following the first one
+// returned at once, and the number of blocks fetched concurrently.
+func (api *BlockChainAPI) blockRangeLimits() (uint64, int) {
+	limit, concurrency := api.b.RPCBlockRangeLimit(), api.b.RPCBlockRangeConcurrency()
+	if limit == 0 {
+		limit = defaultBlockRangeLimit
+	}
+	if concurrency <= 0 {
+		concurrency = defaultBlockRangeConcurrency
+	}
+	return limit, concurrency
+}
+
+// resolveBlockRange resolves the bounds of a block range into block numbers. The
+// range may span at most limit blocks past its start, unless limit is zero, and
+// must not extend beyond the current head.
+func (api *BlockChainAPI) resolveBlockRange(ctx context.Context, startNumber, endNumber rpc.BlockNumber, limit uint64) (uint64, uint64, error) {
+	resolve := func(number rpc.BlockNumber) (uint64, error) {
+		if number >= 0 {
+			return uint64(number), nil
+		}
+		if number == rpc.PendingBlockNumber {
+			return 0, errors.New("pending block is not supported in block ranges")
+		}
+		header, err := api.b.HeaderByNumber(ctx, number)
+		if err != nil {
+			return 0, err
+		}
+		if header == nil {
+			return 0, fmt.Errorf("block %s not found", number)
+		}
+		return header.Number.Uint64(), nil
+	}
+	start, err := resolve(startNumber)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := resolve(endNumber)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, fmt.Errorf("start of block range (%d) is greater than end of block range (%d)", start, end)
+	}
+	if limit > 0 && end-start > limit {
+		return 0, 0, fmt.Errorf("requested block range is too large (max is %d, requested %d blocks)", limit, end-start)
+	}
+	if head := api.b.CurrentBlock().Number.Uint64(); end > head {
+		return 0, 0, fmt.Errorf("end of block range (%d) is beyond the head block (%d)", end, head)
+	}
+	return start, end, nil
+}
+
+// GetBlockRange returns the blocks in the inclusive range [startNumber, endNumber]
+// in ascending order. When fullTx is true all transactions are returned in full
+// detail, and when includeReceipts is true the receipts of every block are
+// returned in its "receipts" field. The blocks are fetched concurrently, and the
+// number of blocks returned at once is limited.
+func (api *BlockChainAPI) GetBlockRange(ctx context.Context, startNumber rpc.BlockNumber, endNumber rpc.BlockNumber, fullTx bool, includeReceipts *bool) ([]map[string]interface{}, error) {
+	limit, concurrency := api.blockRangeLimits()
+	start, end, err := api.resolveBlockRange(ctx, startNumber, endNumber, limit)
+	if err != nil {
+		return nil, err
+	}
+	return api.fetchBlockRange(ctx, start, end, fullTx, includeReceipts != nil && *includeReceipts, concurrency)
+}
+
+// BlockRange creates a subscription streaming the blocks in the inclusive range
+// [startNumber, endNumber] in pages of ascending blocks. Unlike eth_getBlockRange,
+// the size of the range is not limited. The last page of the range, or the page
+// reporting the failure to fetch a block, is flagged as such.
+func (api *BlockChainAPI) BlockRange(ctx context.Context, startNumber rpc.BlockNumber, endNumber rpc.BlockNumber, fullTx bool, includeReceipts *bool) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	limit, concurrency := api.blockRangeLimits()
+	start, end, err := api.resolveBlockRange(ctx, startNumber, endNumber, 0)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		pageSize = min(limit, blockRangePageSize)
+		receipts = includeReceipts != nil && *includeReceipts
+		rpcSub   = notifier.CreateSubscription()
+	)
+	go func() {
+		// Stop fetching blocks as soon as the subscription is gone.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-rpcSub.Err():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		for from := start; from <= end; from += pageSize {
+			to := min(from+pageSize-1, end)
+			blocks, err := api.fetchBlockRange(ctx, from, to, fullTx, receipts, concurrency)
+			if ctx.Err() != nil {
+				return
+			}
+			page := &blockRangePage{Blocks: blocks, Last: to == end || err != nil}
+			if err != nil {
+				page.Error = err.Error()
+			}
+			if notifier.Notify(rpcSub.ID, page) != nil || page.Last {
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// fetchBlockRange fetches the blocks in the inclusive range [start, end] using
+// the given number of concurrent workers, preserving the order of the blocks.
+func (api *BlockChainAPI) fetchBlockRange(ctx context.Context, start, end uint64, fullTx bool, includeReceipts bool, concurrency int) ([]map[string]interface{}, error) {
+	var (
+		blocks = make([]map[string]interface{}, end-start+1)
+		config = api.b.ChainConfig()
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+	for i := range blocks {
+		if gctx.Err() != nil {
+			break
+		}
+		g.Go(func() error {
+			number := start + uint64(i)
+			block, err := api.b.BlockByNumber(gctx, rpc.BlockNumber(number))
+			if err != nil {
+				return err
+			}
+			if block == nil {
+				return fmt.Errorf("block %d not found", number)
+			}
+			fields := RPCMarshalBlock(gctx, block, true, fullTx, config, api.b)
+			if includeReceipts {
+				receipts, err := api.b.GetReceipts(gctx, block.Hash())
+				if err != nil {
+					return err
+				}
+				txs := block.Transactions()
+				if len(txs) != len(receipts) {
+					return fmt.Errorf("receipts length mismatch: %d vs %d", len(txs), len(receipts))
+				}
+				signer := types.MakeSigner(config, block.Number(), block.Time())
+				marshaled := make([]map[string]interface{}, len(receipts))
+				for j, receipt := range receipts {
+					marshaled[j] = MarshalReceipt(receipt, block.Hash(), block.NumberU64(), signer, txs[j], j, config)
+				}
+				fields["receipts"] = marshaled
+			}
+			blocks[i] = fields
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	// Blocks are left unfetched if the request was cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	historicalCacheHitMeter  = metrics.NewRegisteredMeter("rpc/historical/cache/hit", nil)
+	historicalCacheMissMeter = metrics.NewRegisteredMeter("rpc/historical/cache/miss", nil)
+	historicalFailureMeter   = metrics.NewRegisteredMeter("rpc/historical/failure", nil)
+)
+
+// HistoricalRPCConfig configures the fallback to the historical backend.
+type HistoricalRPCConfig struct {
+	Timeout        time.Duration            // Default time allowance of a call, zero means no timeout
+	MethodTimeouts map[string]time.Duration // Per-method time allowances overriding the default one
+	CacheSize      int                      // Size of the response cache in megabytes, zero disables it
+}
+
+// HistoricalRPC forwards calls that need the state of pre-Bedrock blocks to the
+// legacy node serving the chain history. Pre-Bedrock history is immutable, so
+// successful responses are cached.
+type HistoricalRPC struct {
+	client *rpc.Client
+	config HistoricalRPCConfig
+	cache  *lru.SizeConstrainedCache[common.Hash, json.RawMessage]
+}
+
+// NewHistoricalRPC creates a fallback to the historical backend reachable via
+// the given client.
+func NewHistoricalRPC(client *rpc.Client, config HistoricalRPCConfig) *HistoricalRPC {
+	h := &HistoricalRPC{
+		client: client,
+		config: config,
+	}
+	if config.CacheSize > 0 {
+		h.cache = lru.NewSizeConstrainedCache[common.Hash, json.RawMessage](uint64(config.CacheSize) * 1024 * 1024)
+	}
+	return h
+}
+
+// Fallback forwards a call which the node could not serve, as it depends on the
+// state of a pre-Bedrock block, to the historical backend. It is installed as
+// the fallback of the RPC servers, see rpc.Fallback.
+func (h *HistoricalRPC) Fallback(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	var args []any
+	if len(params) > 0 {
+		var raw []json.RawMessage
+		if err := json.Unmarshal(params, &raw); err != nil {
+			return nil, err
+		}
+		for _, arg := range raw {
+			args = append(args, arg)
+		}
+	}
+	var res json.RawMessage
+	if err := h.Call(ctx, &res, method, args...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Call invokes the given method on the historical backend and decodes the
+// response into result. It fails with rpc.ErrNoHistoricalFallback if no
+// historical backend is configured.
+func (h *HistoricalRPC) Call(ctx context.Context, result any, method string, args ...any) error {
+	if h == nil {
+		return rpc.ErrNoHistoricalFallback
+	}
+	var key common.Hash
+	if h.cache != nil {
+		blob, err := json.Marshal(append([]any{method}, args...))
+		if err != nil {
+			return err
+		}
+		key = crypto.Keccak256Hash(blob)
+		if res, ok := h.cache.Get(key); ok {
+			historicalCacheHitMeter.Mark(1)
+			return json.Unmarshal(res, result)
+		}
+		historicalCacheMissMeter.Mark(1)
+	}
+	if timeout := h.timeout(method); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	var res json.RawMessage
+	if err := h.client.CallContext(ctx, &res, method, args...); err != nil {
+		historicalFailureMeter.Mark(1)
+		return fmt.Errorf("historical backend error: %w", err)
+	}
+	if h.cache != nil {
+		h.cache.Add(key, res)
+	}
+	return json.Unmarshal(res, result)
+}
+
+// timeout returns the time allowance of the given method.
+func (h *HistoricalRPC) timeout(method string) time.Duration {
+	if timeout, ok := h.config.MethodTimeouts[method]; ok {
+		return timeout
+	}
+	return h.config.Timeout
+}
+
+// Close closes the connection to the historical backend.
+func (h *HistoricalRPC) Close() {
+	h.client.Close()
+}
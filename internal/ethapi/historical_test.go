@@ -0,0 +1,160 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// testHistoricalService is a legacy node counting the calls it serves.
+type testHistoricalService struct {
+	calls atomic.Int32
+}
+
+func (s *testHistoricalService) GetBalance(addr common.Address, block rpc.BlockNumberOrHash) *hexutil.Big {
+	s.calls.Add(1)
+	return (*hexutil.Big)(common.Big3)
+}
+
+func (s *testHistoricalService) Call(ctx context.Context) (hexutil.Bytes, error) {
+	s.calls.Add(1)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func newTestHistoricalRPC(t *testing.T, config HistoricalRPCConfig) (*HistoricalRPC, *testHistoricalService) {
+	service := new(testHistoricalService)
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(server)
+	t.Cleanup(srv.Close)
+
+	client, err := rpc.Dial(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHistoricalRPC(client, config)
+	t.Cleanup(h.Close)
+	return h, service
+}
+
+func TestHistoricalRPCCache(t *testing.T) {
+	h, service := newTestHistoricalRPC(t, HistoricalRPCConfig{CacheSize: 1})
+	block := rpc.BlockNumberOrHashWithNumber(1)
+
+	for i := 0; i < 3; i++ {
+		var res hexutil.Big
+		if err := h.Call(context.Background(), &res, "eth_getBalance", common.Address{1}, block); err != nil {
+			t.Fatal(err)
+		}
+		if res.ToInt().Cmp(common.Big3) != 0 {
+			t.Fatalf("have balance %v, want 3", res.ToInt())
+		}
+	}
+	if have := service.calls.Load(); have != 1 {
+		t.Fatalf("have %d backend calls, want 1", have)
+	}
+	// Calls with different arguments are not served from the cache.
+	var res hexutil.Big
+	if err := h.Call(context.Background(), &res, "eth_getBalance", common.Address{2}, block); err != nil {
+		t.Fatal(err)
+	}
+	if have := service.calls.Load(); have != 2 {
+		t.Fatalf("have %d backend calls, want 2", have)
+	}
+}
+
+func TestHistoricalRPCTimeout(t *testing.T) {
+	h, _ := newTestHistoricalRPC(t, HistoricalRPCConfig{
+		Timeout:        time.Hour,
+		MethodTimeouts: map[string]time.Duration{"eth_call": 50 * time.Millisecond},
+	})
+	start := time.Now()
+	var res hexutil.Bytes
+	err := h.Call(context.Background(), &res, "eth_call")
+	if err == nil || !strings.HasPrefix(err.Error(), "historical backend error") {
+		t.Fatalf("have error %v, want historical backend timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("method timeout not applied, call took %v", elapsed)
+	}
+}
+
+func TestHistoricalRPCMissing(t *testing.T) {
+	var h *HistoricalRPC
+	if err := h.Call(context.Background(), nil, "eth_getBalance"); !errors.Is(err, rpc.ErrNoHistoricalFallback) {
+		t.Fatalf("have error %v, want %v", err, rpc.ErrNoHistoricalFallback)
+	}
+}
+
+// testLocalService is a node without the state of pre-Bedrock blocks.
+type testLocalService struct{}
+
+func (testLocalService) GetBalance(addr common.Address, block rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	return nil, rpc.ErrNoHistoricalFallback
+}
+
+func TestHistoricalRPCFallback(t *testing.T) {
+	h, service := newTestHistoricalRPC(t, HistoricalRPCConfig{})
+	block := rpc.BlockNumberOrHashWithNumber(5)
+
+	call := func(fallback rpc.Fallback) (*hexutil.Big, error) {
+		server := rpc.NewServer()
+		if err := server.RegisterName("eth", testLocalService{}); err != nil {
+			t.Fatal(err)
+		}
+		server.SetFallback(fallback)
+		defer server.Stop()
+
+		client := rpc.DialInProc(server)
+		defer client.Close()
+
+		var res hexutil.Big
+		err := client.Call(&res, "eth_getBalance", common.Address{1}, block)
+		return &res, err
+	}
+	// Without a fallback, the calls fail.
+	if _, err := call(nil); err == nil || err.Error() != rpc.ErrNoHistoricalFallback.Error() {
+		t.Fatalf("have error %v, want %v", err, rpc.ErrNoHistoricalFallback)
+	}
+	if have := service.calls.Load(); have != 0 {
+		t.Fatalf("have %d backend calls, want 0", have)
+	}
+	// With the historical backend as the fallback, the calls are forwarded.
+	res, err := call(h.Fallback)
+	if err != nil {
+		t.Fatalf("pre-Bedrock call not forwarded: %v", err)
+	}
+	if res.ToInt().Cmp(common.Big3) != 0 {
+		t.Fatalf("have balance %v, want 3", res.ToInt())
+	}
+	if have := service.calls.Load(); have != 1 {
+		t.Fatalf("have %d backend calls, want 1", have)
+	}
+}
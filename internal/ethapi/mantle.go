@@ -0,0 +1,288 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/internal/ethapi/override"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// MantleAPI provides an API to access Mantle specific chain information.
+type MantleAPI struct {
+	b Backend
+}
+
+// NewMantleAPI creates a new Mantle API instance.
+func NewMantleAPI(b Backend) *MantleAPI {
+	return &MantleAPI{b}
+}
+
+// mantleFeatures lists the Mantle feature switches that are in effect at a
+// given fork.
+type mantleFeatures struct {
+	MantleBaseFee bool   `json:"mantleBaseFee"`
+	BVMETHMint    bool   `json:"bvmEthMint"`
+	MetaTx        string `json:"metaTx"` // "disabled", "v1", "v2" or "v3"
+}
+
+type mantleForkConfig struct {
+	Name           string                    `json:"name"`
+	ActivationTime uint64                    `json:"activationTime"`
+	Active         bool                      `json:"active"`
+	Precompiles    map[string]common.Address `json:"precompiles"`
+	Features       mantleFeatures            `json:"features"`
+}
+
+type mantleConfigResponse struct {
+	ChainId      *hexutil.Big        `json:"chainId"`
+	Current      *mantleForkConfig   `json:"current"`
+	Next         *mantleForkConfig   `json:"next"`
+	Forks        []*mantleForkConfig `json:"forks"`
+	ScheduleHash common.Hash         `json:"scheduleHash"`
+}
+
+// Config returns the Mantle upgrade schedule of the chain, listing for every
+// scheduled Mantle fork its activation time, the active precompiles and the
+// Mantle feature switches. The schedule hash allows operators to verify that
+// all nodes agree on the upgrade schedule.
+func (api *MantleAPI) Config(ctx context.Context) (*mantleConfigResponse, error) {
+	genesis, err := api.b.HeaderByNumber(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load genesis: %w", err)
+	}
+	var (
+		c    = api.b.ChainConfig()
+		head = api.b.CurrentHeader().Time
+		resp = &mantleConfigResponse{ChainId: (*hexutil.Big)(c.ChainID)}
+	)
+	for _, fork := range c.MantleForks() {
+		if fork.Time == nil {
+			continue
+		}
+		t := *fork.Time
+
+		// Activation time is reported as 0 if the fork is activated at genesis,
+		// in line with eth_config.
+		activationTime := t
+		if genesis.Time >= t {
+			activationTime = 0
+		}
+		rules := c.Rules(c.LondonBlock, true, t)
+		precompiles := make(map[string]common.Address)
+		for addr, p := range vm.ActivePrecompiledContracts(rules) {
+			precompiles[p.Name()] = addr
+		}
+		resp.Forks = append(resp.Forks, &mantleForkConfig{
+			Name:           fork.Name,
+			ActivationTime: activationTime,
+			Active:         t <= head,
+			Precompiles:    precompiles,
+			Features: mantleFeatures{
+				MantleBaseFee: rules.IsMantleBaseFee,
+				BVMETHMint:    rules.IsMantleBVMETHMintUpgrade,
+				MetaTx:        metaTxVersion(rules),
+			},
+		})
+	}
+	// Forks scheduled at the same time keep their definition order.
+	sort.SliceStable(resp.Forks, func(i, j int) bool {
+		return resp.Forks[i].ActivationTime < resp.Forks[j].ActivationTime
+	})
+	for _, fork := range resp.Forks {
+		if fork.Active {
+			resp.Current = fork
+		} else if resp.Next == nil {
+			resp.Next = fork
+		}
+	}
+	// The schedule hash only covers the static parts of the schedule, so that it
+	// matches across nodes regardless of their current head.
+	schedule := make([]mantleForkConfig, len(resp.Forks))
+	for i, fork := range resp.Forks {
+		schedule[i] = *fork
+		schedule[i].Active = false
+	}
+	blob, err := json.Marshal(schedule)
+	if err != nil {
+		return nil, err
+	}
+	var chainID []byte
+	if c.ChainID != nil {
+		chainID = c.ChainID.Bytes()
+	}
+	resp.ScheduleHash = crypto.Keccak256Hash(chainID, blob)
+	return resp, nil
+}
+
+// metaTxVersion returns the MetaTx version accepted under the given rules.
+func metaTxVersion(rules params.Rules) string {
+	switch {
+	case rules.IsMantleEverest:
+		return "disabled"
+	case rules.IsMetaTxV3:
+		return "v3"
+	case rules.IsMetaTxV2:
+		return "v2"
+	default:
+		return "v1"
+	}
+}
+
+// gasEstimation is the result of mantle_estimateGas.
+type gasEstimation struct {
+	Gas          hexutil.Uint64 `json:"gas"`
+	ExecutionGas hexutil.Uint64 `json:"executionGas"`
+	L1Gas        hexutil.Uint64 `json:"l1Gas"`
+	TokenRatio   *hexutil.Big   `json:"tokenRatio"`
+}
+
+// EstimateGas estimates the gas limit of a transaction like eth_estimateGas, but
+// also reports the parts the gas limit is made of: the execution gas before the
+// token ratio scaling, the gas covering the L1 data fee and the token ratio the
+// estimate is priced with. Unless given, the token ratio margin configured for
+// eth_estimateGas is applied.
+func (api *MantleAPI) EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *override.StateOverride, blockOverrides *override.BlockOverrides, tokenRatioMargin *float64) (*gasEstimation, error) {
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	margin := api.b.RPCTokenRatioMargin()
+	if tokenRatioMargin != nil {
+		if *tokenRatioMargin < 0 {
+			return nil, errors.New("token ratio margin must not be negative")
+		}
+		margin = *tokenRatioMargin
+	}
+	estimate, err := doEstimateGas(ctx, api.b, args, bNrOrHash, overrides, blockOverrides, api.b.RPCGasCap(), margin)
+	if err != nil {
+		return nil, err
+	}
+	return &gasEstimation{
+		Gas:          hexutil.Uint64(estimate.Gas * gasBuffer / 100),
+		ExecutionGas: hexutil.Uint64(estimate.ExecutionGas),
+		L1Gas:        hexutil.Uint64(estimate.L1Gas),
+		TokenRatio:   (*hexutil.Big)(estimate.TokenRatio),
+	}, nil
+}
+
+// l1FeeParamsResult is the result of mantle_getL1FeeParams.
+type l1FeeParamsResult struct {
+	L1BaseFee  *hexutil.Big `json:"l1BaseFee"`
+	Overhead   *hexutil.Big `json:"overhead"`
+	Scalar     *hexutil.Big `json:"scalar"`
+	TokenRatio *hexutil.Big `json:"tokenRatio"`
+	Predicted  bool         `json:"predicted"`
+}
+
+// GetL1FeeParams returns the fee oracle values the L1 fee and the token ratio
+// scaling of transactions are computed from, as in effect after the given block.
+// For the pending block, the values are predicted from the pending deposits
+// known to the node, if any, in which case predicted is set.
+func (api *MantleAPI) GetL1FeeParams(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*l1FeeParamsResult, error) {
+	var (
+		fees      *types.L1FeeParams
+		predicted bool
+	)
+	if number, ok := blockNrOrHash.Number(); ok && number == rpc.PendingBlockNumber {
+		var err error
+		if fees, predicted, err = pendingL1FeeParams(ctx, api.b); err != nil {
+			return nil, err
+		}
+	} else {
+		state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+		if state == nil || err != nil {
+			return nil, err
+		}
+		fees = types.ReadL1FeeParams(state)
+	}
+	return &l1FeeParamsResult{
+		L1BaseFee:  (*hexutil.Big)(fees.L1BaseFee),
+		Overhead:   (*hexutil.Big)(fees.Overhead),
+		Scalar:     (*hexutil.Big)(fees.Scalar),
+		TokenRatio: (*hexutil.Big)(fees.TokenRatio),
+		Predicted:  predicted,
+	}, nil
+}
+
+// pendingL1FeeParams returns the fee oracle values of the pending block. These
+// are predicted from the known pending deposits if possible, and read from the
+// pending state otherwise.
+func pendingL1FeeParams(ctx context.Context, b Backend) (*types.L1FeeParams, bool, error) {
+	if fees := b.PendingL1FeeParams(); fees != nil {
+		return fees, true, nil
+	}
+	state, _, err := b.StateAndHeaderByNumber(ctx, rpc.PendingBlockNumber)
+	if err != nil {
+		return nil, false, err
+	}
+	if state == nil {
+		return nil, false, errors.New("pending state is not available")
+	}
+	return types.ReadL1FeeParams(state), false, nil
+}
+
+// ReceiptL1Fee is the L1 data fee charged to a transaction, as included in the
+// receipt returned by MarshalReceipt.
+type ReceiptL1Fee struct {
+	GasPrice   *hexutil.Big
+	GasUsed    *hexutil.Big
+	Fee        *hexutil.Big
+	FeeScalar  string
+	TokenRatio *hexutil.Big
+}
+
+// RollupReceiptFields are the rollup specific fields of a receipt. They are
+// shared by all the APIs exposing receipts, so that these report the same
+// values.
+type RollupReceiptFields struct {
+	L1           *ReceiptL1Fee   // L1 data fee, nil for deposits and non-rollup chains
+	DepositNonce *hexutil.Uint64 // Nonce of the deposit sender, nil for other transactions
+}
+
+// NewRollupReceiptFields derives the rollup specific fields of the receipt of
+// the given transaction.
+func NewRollupReceiptFields(receipt *types.Receipt, tx *types.Transaction, chainConfig *params.ChainConfig) RollupReceiptFields {
+	var fields RollupReceiptFields
+	if chainConfig.Optimism == nil {
+		return fields
+	}
+	if !tx.IsDepositTx() {
+		fields.L1 = &ReceiptL1Fee{
+			GasPrice:   (*hexutil.Big)(receipt.L1GasPrice),
+			GasUsed:    (*hexutil.Big)(receipt.L1GasUsed),
+			Fee:        (*hexutil.Big)(receipt.L1Fee),
+			FeeScalar:  receipt.FeeScalar.String(),
+			TokenRatio: (*hexutil.Big)(receipt.TokenRatio),
+		}
+	} else if receipt.DepositNonce != nil {
+		nonce := hexutil.Uint64(*receipt.DepositNonce)
+		fields.DepositNonce = &nonce
+	}
+	return fields
+}
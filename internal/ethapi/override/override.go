@@ -131,6 +131,12 @@ type BlockOverrides struct {
 	BlobBaseFee   *hexutil.Big
 	BeaconRoot    *common.Hash
 	Withdrawals   *types.Withdrawals
+
+	// Mantle L1 fee parameters, written to the storage of the L1Block and
+	// GasPriceOracle predeploys. Only supported by eth_simulateV1.
+	L1BaseFee   *hexutil.Big
+	L1FeeScalar *hexutil.Big
+	TokenRatio  *hexutil.Big
 }
 
 // Apply overrides the given header fields into the given block context.
@@ -144,6 +150,9 @@ func (o *BlockOverrides) Apply(blockCtx *vm.BlockContext) error {
 	if o.Withdrawals != nil {
 		return errors.New(`block override "withdrawals" is not supported for this RPC method`)
 	}
+	if o.L1BaseFee != nil || o.L1FeeScalar != nil || o.TokenRatio != nil {
+		return errors.New(`block overrides of the L1 fee parameters are not supported for this RPC method`)
+	}
 	if o.Number != nil {
 		blockCtx.BlockNumber = o.Number.ToInt()
 	}
@@ -203,3 +212,22 @@ func (o *BlockOverrides) MakeHeader(header *types.Header) *types.Header {
 	}
 	return h
 }
+
+// ApplyL1Fees writes the overridden L1 fee parameters into the storage of the
+// oracle predeploys, where they are picked up by the L1 cost function and the
+// receipts of the following transactions.
+func (o *BlockOverrides) ApplyL1Fees(statedb *state.StateDB) {
+	if o == nil || (o.L1BaseFee == nil && o.L1FeeScalar == nil && o.TokenRatio == nil) {
+		return
+	}
+	if o.L1BaseFee != nil {
+		statedb.SetState(types.L1BlockAddr, types.L1BaseFeeSlot, common.BigToHash(o.L1BaseFee.ToInt()))
+	}
+	if o.L1FeeScalar != nil {
+		statedb.SetState(types.L1BlockAddr, types.ScalarSlot, common.BigToHash(o.L1FeeScalar.ToInt()))
+	}
+	if o.TokenRatio != nil {
+		statedb.SetState(types.GasOracleAddr, types.TokenRatioSlot, common.BigToHash(o.TokenRatio.ToInt()))
+	}
+	statedb.Finalise(false)
+}
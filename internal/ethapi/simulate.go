@@ -33,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/internal/ethapi/override"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -61,6 +62,26 @@ type simCallResult struct {
 	GasUsed     hexutil.Uint64 `json:"gasUsed"`
 	Status      hexutil.Uint64 `json:"status"`
 	Error       *callError     `json:"error,omitempty"`
+
+	// Rollup specific receipt fields, as returned by eth_getTransactionReceipt.
+	L1GasPrice   *hexutil.Big    `json:"l1GasPrice,omitempty"`
+	L1GasUsed    *hexutil.Big    `json:"l1GasUsed,omitempty"`
+	L1Fee        *hexutil.Big    `json:"l1Fee,omitempty"`
+	L1FeeScalar  string          `json:"l1FeeScalar,omitempty"`
+	TokenRatio   *hexutil.Big    `json:"tokenRatio,omitempty"`
+	DepositNonce *hexutil.Uint64 `json:"depositNonce,omitempty"`
+}
+
+// setRollupFields copies the rollup specific receipt fields into the result.
+func (r *simCallResult) setRollupFields(fields RollupReceiptFields) {
+	if l1 := fields.L1; l1 != nil {
+		r.L1GasPrice = l1.GasPrice
+		r.L1GasUsed = l1.GasUsed
+		r.L1Fee = l1.Fee
+		r.L1FeeScalar = l1.FeeScalar
+		r.TokenRatio = l1.TokenRatio
+	}
+	r.DepositNonce = fields.DepositNonce
 }
 
 func (r *simCallResult) MarshalJSON() ([]byte, error) {
@@ -205,18 +226,18 @@ func (sim *simulator) execute(ctx context.Context, blocks []simBlock) ([]*simBlo
 		parent  = sim.base
 	)
 	for bi, block := range blocks {
-		result, callResults, senders, err := sim.processBlock(ctx, &block, headers[bi], parent, headers[:bi], timeout)
+		result, callResults, senders, receipts, err := sim.processBlock(ctx, &block, headers[bi], parent, headers[:bi], timeout)
 		if err != nil {
 			return nil, err
 		}
 		headers[bi] = result.Header()
-		results[bi] = &simBlockResult{fullTx: sim.fullTx, chainConfig: sim.chainConfig, Block: result, Calls: callResults, senders: senders}
+		results[bi] = &simBlockResult{fullTx: sim.fullTx, chainConfig: sim.chainConfig, Block: result, Calls: callResults, senders: senders, Receipts: receipts}
 		parent = result.Header()
 	}
 	return results, nil
 }
 
-func (sim *simulator) processBlock(ctx context.Context, block *simBlock, header, parent *types.Header, headers []*types.Header, timeout time.Duration) (*types.Block, []simCallResult, map[common.Hash]common.Address, error) {
+func (sim *simulator) processBlock(ctx context.Context, block *simBlock, header, parent *types.Header, headers []*types.Header, timeout time.Duration) (*types.Block, []simCallResult, map[common.Hash]common.Address, types.Receipts, error) {
 	// Set header fields that depend only on parent block.
 	// Parent hash is needed for evm.GetHashFn to work.
 	header.ParentHash = parent.Hash()
@@ -246,8 +267,11 @@ func (sim *simulator) processBlock(ctx context.Context, block *simBlock, header,
 	precompiles := sim.activePrecompiles(sim.base)
 	// State overrides are applied prior to execution of a block
 	if err := block.StateOverrides.Apply(sim.state, precompiles); err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
+	// L1 fee parameter overrides persist in the oracle storage, similarly to
+	// state overrides.
+	block.BlockOverrides.ApplyL1Fees(sim.state)
 	var (
 		gasUsed, blobGasUsed uint64
 		txes                 = make([]*types.Transaction, len(block.Calls))
@@ -283,25 +307,37 @@ func (sim *simulator) processBlock(ctx context.Context, block *simBlock, header,
 	var allLogs []*types.Log
 	for i, call := range block.Calls {
 		if err := ctx.Err(); err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, nil, err
 		}
 		if err := sim.sanitizeCall(&call, sim.state, header, blockContext, &gasUsed); err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, nil, err
 		}
 		var (
-			tx     = call.ToTransaction(types.DynamicFeeTxType)
-			txHash = tx.Hash()
+			tx    *types.Transaction
+			nonce uint64
 		)
+		if call.isDeposit() {
+			sourceHash := crypto.Keccak256Hash(common.BigToHash(header.Number).Bytes(), common.BigToHash(big.NewInt(int64(i))).Bytes())
+			if call.SourceHash != nil {
+				sourceHash = *call.SourceHash
+			}
+			tx = call.toDepositTransaction(sourceHash)
+			// The deposit nonce is recorded as the sender nonce prior to execution.
+			nonce = sim.state.GetNonce(call.from())
+		} else {
+			tx = call.ToTransaction(types.DynamicFeeTxType)
+			nonce = tx.Nonce()
+		}
+		txHash := tx.Hash()
 		txes[i] = tx
 		senders[txHash] = call.from()
 		tracer.reset(txHash, uint(i))
 		sim.state.SetTxContext(txHash, i)
-		// EoA check is always skipped, even in validation mode.
-		msg := call.ToMessage(header.BaseFee, !sim.validate, core.EthcallMode, call.GasPrice)
+		msg := sim.toMessage(&call, tx, header)
 		result, err := applyMessageWithEVM(ctx, evm, msg, timeout, sim.gp)
 		if err != nil {
 			txErr := txValidationError(err)
-			return nil, nil, nil, txErr
+			return nil, nil, nil, nil, txErr
 		}
 		// Update the state with pending changes.
 		var root []byte
@@ -311,10 +347,11 @@ func (sim *simulator) processBlock(ctx context.Context, block *simBlock, header,
 			root = sim.state.IntermediateRoot(sim.chainConfig.IsEIP158(blockContext.BlockNumber)).Bytes()
 		}
 		gasUsed += result.UsedGas
-		receipts[i] = core.MakeReceipt(evm, result, sim.state, blockContext.BlockNumber, common.Hash{}, blockContext.Time, tx, gasUsed, root, sim.chainConfig, tx.Nonce())
+		receipts[i] = core.MakeReceipt(evm, result, sim.state, blockContext.BlockNumber, common.Hash{}, blockContext.Time, tx, gasUsed, root, sim.chainConfig, nonce)
 		blobGasUsed += receipts[i].BlobGasUsed
 		logs := tracer.Logs()
 		callRes := simCallResult{ReturnValue: result.Return(), Logs: logs, GasUsed: hexutil.Uint64(result.UsedGas)}
+		callRes.setRollupFields(NewRollupReceiptFields(receipts[i], tx, sim.chainConfig))
 		if result.Failed() {
 			callRes.Status = hexutil.Uint64(types.ReceiptStatusFailed)
 			if errors.Is(result.Err, vm.ErrExecutionReverted) {
@@ -340,15 +377,15 @@ func (sim *simulator) processBlock(ctx context.Context, block *simBlock, header,
 		requests = [][]byte{}
 		// EIP-6110
 		if err := core.ParseDepositLogs(&requests, allLogs, sim.chainConfig); err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, nil, err
 		}
 		// EIP-7002
 		if err := core.ProcessWithdrawalQueue(&requests, evm); err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, nil, err
 		}
 		// EIP-7251
 		if err := core.ProcessConsolidationQueue(&requests, evm); err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, nil, err
 		}
 	}
 	if requests != nil {
@@ -359,10 +396,10 @@ func (sim *simulator) processBlock(ctx context.Context, block *simBlock, header,
 	chainHeadReader := &simChainHeadReader{ctx, sim.b}
 	b, err := sim.b.Engine().FinalizeAndAssemble(chainHeadReader, header, sim.state, blockBody, receipts)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	repairLogs(callResults, b.Hash())
-	return b, callResults, senders, nil
+	return b, callResults, senders, receipts, nil
 }
 
 // repairLogs updates the block hash in the logs present in the result of
@@ -389,12 +426,98 @@ func (sim *simulator) sanitizeCall(call *TransactionArgs, state vm.StateDB, head
 	if *gasUsed+uint64(*call.Gas) > blockContext.GasLimit {
 		return &blockGasLimitReachedError{fmt.Sprintf("block gas limit reached: %d >= %d", gasUsed, blockContext.GasLimit)}
 	}
-	if err := call.CallDefaults(sim.gp.Gas(), header.BaseFee, sim.chainConfig.ChainID); err != nil {
+	if err := call.callDefaults(sim.gp.Gas(), header.BaseFee, sim.chainConfig.ChainID); err != nil {
 		return err
 	}
+	if call.isDeposit() {
+		if sim.chainConfig.Optimism == nil {
+			return &invalidParamsError{"deposit transactions are not supported on this chain"}
+		}
+		if call.GasFeeSponsor != nil {
+			return &invalidParamsError{"deposit transactions can not be sponsored"}
+		}
+	}
+	if call.SponsorPercent != nil {
+		if call.GasFeeSponsor == nil {
+			return &invalidParamsError{"sponsorPercent specified without gasFeeSponsor"}
+		}
+		if percent := uint64(*call.SponsorPercent); percent == 0 || percent > types.OneHundredPercent {
+			return &invalidParamsError{fmt.Sprintf("invalid sponsorPercent %d", percent)}
+		}
+	}
+	if call.GasFeeSponsor != nil {
+		// The balances are only checked in validation mode, leaving nothing to sponsor.
+		if !sim.validate {
+			return &invalidParamsError{"gasFeeSponsor is only supported in validation mode"}
+		}
+		if err := checkSponsorFunds(call, state, sponsorParams(call, header)); err != nil {
+			return txValidationError(err)
+		}
+	}
 	return nil
 }
 
+// sponsorParams returns the MetaTx parameters of a sponsored call.
+func sponsorParams(call *TransactionArgs, header *types.Header) *types.MetaTxParams {
+	percent := uint64(types.OneHundredPercent)
+	if call.SponsorPercent != nil {
+		percent = uint64(*call.SponsorPercent)
+	}
+	return &types.MetaTxParams{
+		ExpireHeight:   header.Number.Uint64(),
+		SponsorPercent: percent,
+		Payload:        call.data(),
+		GasFeeSponsor:  *call.GasFeeSponsor,
+	}
+}
+
+// checkSponsorFunds verifies that the sponsor and the sender of a sponsored
+// call can afford their shares of the gas fee, as checked for the MetaTx
+// transactions when buying gas. Calls are executed in eth_call mode, so the
+// balances are checked here but not charged.
+func checkSponsorFunds(call *TransactionArgs, state vm.StateDB, params *types.MetaTxParams) error {
+	price := call.GasPrice
+	if call.MaxFeePerGas != nil {
+		price = call.MaxFeePerGas
+	}
+	fee := new(big.Int).SetUint64(uint64(*call.Gas))
+	fee.Mul(fee, price.ToInt())
+	value := new(big.Int)
+	if call.Value != nil {
+		value.Set(call.Value.ToInt())
+	}
+	sponsorAmount, selfPayAmount := types.CalculateSponsorPercentAmount(params, fee)
+	selfPayAmount.Add(selfPayAmount, value)
+	if params.GasFeeSponsor == call.from() {
+		sponsorAmount, selfPayAmount = new(big.Int), fee.Add(fee, value)
+	}
+	if have := state.GetBalance(params.GasFeeSponsor).ToBig(); have.Cmp(sponsorAmount) < 0 {
+		return fmt.Errorf("%w: gas fee sponsor %v have %v want %v", core.ErrInsufficientFunds, params.GasFeeSponsor.Hex(), have, sponsorAmount)
+	}
+	if have := state.GetBalance(call.from()).ToBig(); have.Cmp(selfPayAmount) < 0 {
+		return fmt.Errorf("%w: address %v have %v want %v", core.ErrInsufficientFunds, call.from().Hex(), have, selfPayAmount)
+	}
+	return nil
+}
+
+// toMessage converts a sanitized call into the message to execute. Deposits are
+// executed as such, and sponsored calls carry their MetaTx parameters.
+func (sim *simulator) toMessage(call *TransactionArgs, tx *types.Transaction, header *types.Header) *core.Message {
+	// EoA check is always skipped, even in validation mode.
+	msg := call.ToMessage(header.BaseFee, !sim.validate, core.EthcallMode, call.GasPrice)
+	if tx.IsDepositTx() {
+		msg.IsDepositTx = true
+		msg.IsSystemTx = tx.IsSystemTx()
+		msg.Mint = tx.Mint()
+		msg.ETHValue = tx.ETHValue()
+		msg.ETHTxValue = tx.ETHTxValue()
+	}
+	if call.GasFeeSponsor != nil {
+		msg.MetaTxParams = sponsorParams(call, header)
+	}
+	return msg
+}
+
 func (sim *simulator) activePrecompiles(base *types.Header) vm.PrecompiledContracts {
 	var (
 		isMerge = (base.Difficulty.Sign() == 0)
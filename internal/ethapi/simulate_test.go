@@ -17,12 +17,21 @@
 package ethapi
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi/override"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 )
 
 func TestSimulateSanitizeBlockOrder(t *testing.T) {
@@ -116,6 +125,107 @@ func TestSimulateSanitizeBlockOrder(t *testing.T) {
 	}
 }
 
+// simRollupBackend is a backend able to assemble simulated rollup blocks.
+type simRollupBackend struct {
+	*backendMock
+}
+
+func (b *simRollupBackend) Engine() consensus.Engine { return beacon.New(ethash.NewFaker()) }
+
+func TestSimulateRollupCalls(t *testing.T) {
+	// MetaTx sponsorship is disabled from Everest onwards.
+	config := *core.DeveloperRollupGenesisBlock(0, nil).Config
+	config.PragueTime, config.OsakaTime = nil, nil
+	config.MantleEverestTime, config.MantleSkadiTime, config.MantleLimbTime = nil, nil, nil
+
+	var (
+		user    = common.Address{0x01}
+		to      = common.Address{0x02}
+		sponsor = common.Address{0x03}
+		mint    = big.NewInt(params.Ether)
+		value   = big.NewInt(params.GWei)
+		gas     = hexutil.Uint64(100_000)
+		gasFee  = (*hexutil.Big)(big.NewInt(2 * params.GWei))
+		funds   = uint256.NewInt(params.Ether)
+	)
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	statedb.SetBalance(sponsor, funds, 0)
+	statedb.SetState(types.GasOracleAddr, types.TokenRatioSlot, common.BigToHash(common.Big1))
+
+	backend := &simRollupBackend{newBackendMock()}
+	backend.config = &config
+	sim := &simulator{
+		b:           backend,
+		state:       statedb,
+		base:        &types.Header{Number: big.NewInt(10), Time: 100, Difficulty: common.Big0, GasLimit: 30_000_000, BaseFee: big.NewInt(params.GWei)},
+		chainConfig: &config,
+		gp:          new(core.GasPool).AddGas(30_000_000),
+		validate:    true,
+	}
+	results, err := sim.execute(context.Background(), []simBlock{{
+		BlockOverrides: &override.BlockOverrides{
+			BaseFeePerGas: (*hexutil.Big)(big.NewInt(params.GWei)),
+			L1BaseFee:     (*hexutil.Big)(big.NewInt(10 * params.GWei)),
+			L1FeeScalar:   (*hexutil.Big)(big.NewInt(1_000_000)),
+			TokenRatio:    (*hexutil.Big)(big.NewInt(2)),
+		},
+		Calls: []TransactionArgs{
+			// A deposit minting the funds of the user
+			{From: &user, To: &to, Gas: &gas, Mint: (*hexutil.Big)(mint)},
+			// A call of the user, whose gas fee is paid by the sponsor
+			{From: &user, To: &to, Gas: &gas, Value: (*hexutil.Big)(value), MaxFeePerGas: gasFee, MaxPriorityFeePerGas: new(hexutil.Big), GasFeeSponsor: &sponsor},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("simulation failed: %v", err)
+	}
+	calls := results[0].Calls
+	for i, call := range calls {
+		if call.Status != hexutil.Uint64(types.ReceiptStatusSuccessful) {
+			t.Fatalf("call %d failed: %v", i, call.Error)
+		}
+	}
+	// The deposit reports the sender nonce instead of an L1 fee.
+	if calls[0].DepositNonce == nil || *calls[0].DepositNonce != 0 || calls[0].L1Fee != nil {
+		t.Errorf("unexpected deposit receipt fields: nonce %v, L1 fee %v", calls[0].DepositNonce, calls[0].L1Fee)
+	}
+	// The call is charged the L1 fee at the overridden parameters.
+	tx := results[0].Block.Transactions()[1]
+	want := types.L1Cost(tx.RollupCostData().DataGas(results[0].Block.Time(), &config), big.NewInt(10*params.GWei), common.Big0, big.NewInt(1_000_000), big.NewInt(2))
+	if calls[1].L1Fee == nil || calls[1].L1Fee.ToInt().Cmp(want) != 0 || calls[1].TokenRatio.ToInt().Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("L1 fee mismatch: have %v (token ratio %v), want %v", calls[1].L1Fee, calls[1].TokenRatio, want)
+	}
+	if calls[1].DepositNonce != nil {
+		t.Errorf("deposit nonce set for regular call")
+	}
+	// Gas is not charged in eth_call mode, the user only pays the transferred value.
+	if have, want := statedb.GetBalance(user).ToBig(), new(big.Int).Sub(mint, value); have.Cmp(want) != 0 {
+		t.Errorf("user balance mismatch: have %v, want %v", have, want)
+	}
+	if have := statedb.GetBalance(sponsor); have.Cmp(funds) != 0 {
+		t.Errorf("sponsor balance mismatch: have %v, want %v", have, funds)
+	}
+	// Sponsors unable to pay their share of the gas fee are rejected.
+	sim.gp = new(core.GasPool).AddGas(30_000_000)
+	percent := hexutil.Uint64(50)
+	_, err = sim.execute(context.Background(), []simBlock{{
+		Calls: []TransactionArgs{
+			{From: &user, To: &to, Gas: &gas, MaxFeePerGas: (*hexutil.Big)(funds.ToBig()), MaxPriorityFeePerGas: new(hexutil.Big), GasFeeSponsor: &sponsor, SponsorPercent: &percent},
+		},
+	}})
+	if txErr, ok := err.(*invalidTxError); !ok || txErr.Code != errCodeInsufficientFunds {
+		t.Errorf("expected insufficient funds error of the sponsor, got %v", err)
+	}
+	// Sponsorship is rejected outside of validation mode, as gas is not charged.
+	sim.validate = false
+	_, err = sim.execute(context.Background(), []simBlock{{
+		Calls: []TransactionArgs{{From: &user, To: &to, GasFeeSponsor: &sponsor}},
+	}})
+	if _, ok := err.(*invalidParamsError); !ok {
+		t.Errorf("expected invalid params error without validation, got %v", err)
+	}
+}
+
 func newInt(n int64) *hexutil.Big {
 	return (*hexutil.Big)(big.NewInt(n))
 }
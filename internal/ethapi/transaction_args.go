@@ -70,6 +70,21 @@ type TransactionArgs struct {
 
 	// For SetCodeTxType
 	AuthorizationList []types.SetCodeAuthorization `json:"authorizationList"`
+
+	// For simulated deposit transactions, only supported by eth_simulateV1 and
+	// rejected by the other methods
+	SourceHash *common.Hash `json:"sourceHash,omitempty"`
+	Mint       *hexutil.Big `json:"mint,omitempty"`
+	EthValue   *hexutil.Big `json:"ethValue,omitempty"`
+	EthTxValue *hexutil.Big `json:"ethTxValue,omitempty"`
+	IsSystemTx *bool        `json:"isSystemTx,omitempty"`
+
+	// For simulated MetaTx gas fee sponsorship, only supported by eth_simulateV1
+	// and rejected by the other methods. The simulated calls are not charged for
+	// gas, sponsorship only checks that the sponsor and the sender can afford
+	// their shares of the gas fee.
+	GasFeeSponsor  *common.Address `json:"gasFeeSponsor,omitempty"`
+	SponsorPercent *hexutil.Uint64 `json:"sponsorPercent,omitempty"`
 }
 
 // from retrieves the transaction sender address.
@@ -91,6 +106,20 @@ func (args *TransactionArgs) data() []byte {
 	return nil
 }
 
+// isDeposit reports whether the arguments describe a deposit transaction.
+func (args *TransactionArgs) isDeposit() bool {
+	return args.SourceHash != nil || args.Mint != nil || args.EthValue != nil || args.EthTxValue != nil || args.IsSystemTx != nil
+}
+
+// checkSimulateOnly rejects the deposit and gas fee sponsorship fields, which
+// are only supported by eth_simulateV1.
+func (args *TransactionArgs) checkSimulateOnly() error {
+	if args.isDeposit() || args.GasFeeSponsor != nil || args.SponsorPercent != nil {
+		return errors.New(`deposit and gas fee sponsorship fields are not supported for this RPC method`)
+	}
+	return nil
+}
+
 // sidecarConfig defines the options for deriving missing fields of transactions.
 type sidecarConfig struct {
 	// This configures whether blobs are allowed to be passed and
@@ -101,6 +130,9 @@ type sidecarConfig struct {
 
 // setDefaults fills in default values for unspecified tx fields.
 func (args *TransactionArgs) setDefaults(ctx context.Context, b Backend, config sidecarConfig) error {
+	if err := args.checkSimulateOnly(); err != nil {
+		return err
+	}
 	if err := args.setBlobTxSidecar(ctx, config); err != nil {
 		return err
 	}
@@ -389,6 +421,15 @@ func (args *TransactionArgs) setBlobTxSidecar(ctx context.Context, config sideca
 // CallDefaults sanitizes the transaction arguments, often filling in zero values,
 // for the purpose of eth_call class of RPC methods.
 func (args *TransactionArgs) CallDefaults(globalGasCap uint64, baseFee *big.Int, chainID *big.Int) error {
+	if err := args.checkSimulateOnly(); err != nil {
+		return err
+	}
+	return args.callDefaults(globalGasCap, baseFee, chainID)
+}
+
+// callDefaults is CallDefaults accepting the fields only supported by
+// eth_simulateV1, which validates them itself.
+func (args *TransactionArgs) callDefaults(globalGasCap uint64, baseFee *big.Int, chainID *big.Int) error {
 	// Reject invalid combinations of pre- and post-1559 fee styles
 	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
 		return errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
@@ -618,6 +659,23 @@ func (args *TransactionArgs) ToTransaction(defaultType int) *types.Transaction {
 	return types.NewTx(data)
 }
 
+// toDepositTransaction converts the arguments to a deposit transaction with the
+// given source hash. This assumes that CallDefaults has been called.
+func (args *TransactionArgs) toDepositTransaction(sourceHash common.Hash) *types.Transaction {
+	return types.NewTx(&types.DepositTx{
+		SourceHash:          sourceHash,
+		From:                args.from(),
+		To:                  args.To,
+		Mint:                (*big.Int)(args.Mint),
+		Value:               (*big.Int)(args.Value),
+		Gas:                 uint64(*args.Gas),
+		IsSystemTransaction: args.IsSystemTx != nil && *args.IsSystemTx,
+		EthValue:            (*big.Int)(args.EthValue),
+		Data:                args.data(),
+		EthTxValue:          (*big.Int)(args.EthTxValue),
+	})
+}
+
 // IsEIP4844 returns an indicator if the args contains EIP4844 fields.
 func (args *TransactionArgs) IsEIP4844() bool {
 	return args.BlobHashes != nil || args.BlobFeeCap != nil
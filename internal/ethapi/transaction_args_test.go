@@ -256,6 +256,45 @@ func TestSetFeeDefaults(t *testing.T) {
 	}
 }
 
+// TestSimulateOnlyArgs checks that the deposit and sponsorship fields are
+// rejected outside of eth_simulateV1.
+func TestSimulateOnlyArgs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b       = newBackendMock()
+		hash    = common.Hash{0x01}
+		sponsor = common.Address{0x02}
+		yes     = true
+		percent = hexutil.Uint64(50)
+		one     = (*hexutil.Big)(big.NewInt(1))
+	)
+	tests := []TransactionArgs{
+		{SourceHash: &hash},
+		{Mint: one},
+		{EthValue: one},
+		{EthTxValue: one},
+		{IsSystemTx: &yes},
+		{GasFeeSponsor: &sponsor},
+		{SponsorPercent: &percent},
+	}
+	for i, args := range tests {
+		call := args
+		if err := call.CallDefaults(0, b.current.BaseFee, b.config.ChainID); err == nil {
+			t.Errorf("test %d: call defaults accepted simulation-only fields", i)
+		}
+		send := args
+		if err := send.setDefaults(context.Background(), b, sidecarConfig{}); err == nil {
+			t.Errorf("test %d: transaction defaults accepted simulation-only fields", i)
+		}
+		// The simulator sets the defaults of the calls without the check.
+		sim := args
+		if err := sim.callDefaults(0, b.current.BaseFee, b.config.ChainID); err != nil {
+			t.Errorf("test %d: simulation rejected: %v", i, err)
+		}
+	}
+}
+
 type backendMock struct {
 	current *types.Header
 	config  *params.ChainConfig
@@ -335,6 +374,9 @@ func (b *backendMock) ExtRPCEnabled() bool               { return false }
 func (b *backendMock) RPCGasCap() uint64                 { return 0 }
 func (b *backendMock) RPCEVMTimeout() time.Duration      { return time.Second }
 func (b *backendMock) RPCTxFeeCap() float64              { return 0 }
+func (b *backendMock) RPCBlockRangeLimit() uint64        { return 0 }
+func (b *backendMock) RPCBlockRangeConcurrency() int     { return 0 }
+func (b *backendMock) RPCTokenRatioMargin() float64      { return 0 }
 func (b *backendMock) UnprotectedAllowed() bool          { return false }
 func (b *backendMock) SetHead(number uint64)             {}
 func (b *backendMock) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
@@ -366,6 +408,7 @@ func (b *backendMock) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrO
 	return nil, nil, nil
 }
 func (b *backendMock) Pending() (*types.Block, types.Receipts, *state.StateDB) { return nil, nil, nil }
+func (b *backendMock) PendingL1FeeParams() *types.L1FeeParams                  { return nil }
 func (b *backendMock) GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
 	return nil, nil
 }
@@ -418,5 +461,4 @@ func (b *backendMock) NewMatcherBackend() filtermaps.MatcherBackend { return nil
 
 func (b *backendMock) HistoryPruningCutoff() uint64 { return 0 }
 
-func (b *backendMock) HistoricalRPCService() *rpc.Client { return nil }
-func (b *backendMock) Genesis() *types.Block             { return nil }
+func (b *backendMock) Genesis() *types.Block { return nil }
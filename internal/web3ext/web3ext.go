@@ -27,6 +27,8 @@ var Modules = map[string]string{
 	"rpc":    RpcJs,
 	"txpool": TxpoolJs,
 	"dev":    DevJs,
+	"mantle": MantleJs,
+	"trace":  TraceJs,
 }
 
 const CliqueJs = `
@@ -158,6 +160,15 @@ web3._extend({
 			name: 'stopWS',
 			call: 'admin_stopWS'
 		}),
+		new web3._extend.Method({
+			name: 'setRPCRateLimits',
+			call: 'admin_setRPCRateLimits',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'reloadRPCAPIKeys',
+			call: 'admin_reloadRPCAPIKeys'
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -172,6 +183,10 @@ web3._extend({
 			name: 'datadir',
 			getter: 'admin_datadir'
 		}),
+		new web3._extend.Property({
+			name: 'rpcRateLimits',
+			getter: 'admin_rpcRateLimits'
+		}),
 	]
 });
 `
@@ -399,6 +414,12 @@ web3._extend({
 			params: 3,
 			inputFormatter: [null, null, null]
 		}),
+		new web3._extend.Method({
+			name: 'traceCallMany',
+			call: 'debug_traceCallMany',
+			params: 3,
+			inputFormatter: [null, null, null]
+		}),
 		new web3._extend.Method({
 			name: 'preimage',
 			call: 'debug_preimage',
@@ -728,6 +749,56 @@ web3._extend({
 			call: 'dev_setFeeRecipient',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'addDeposit',
+			call: 'dev_addDeposit',
+			params: 1
+		}),
+	],
+});
+`
+
+const MantleJs = `
+web3._extend({
+	property: 'mantle',
+	methods:
+	[
+		new web3._extend.Method({
+			name: 'config',
+			call: 'mantle_config',
+			params: 0,
+		}),
+		new web3._extend.Method({
+			name: 'getL1FeeParams',
+			call: 'mantle_getL1FeeParams',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter],
+		}),
+	],
+});
+`
+
+const TraceJs = `
+web3._extend({
+	property: 'trace',
+	methods:
+	[
+		new web3._extend.Method({
+			name: 'replayTransaction',
+			call: 'trace_replayTransaction',
+			params: 2,
+		}),
+		new web3._extend.Method({
+			name: 'replayBlockTransactions',
+			call: 'trace_replayBlockTransactions',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, null],
+		}),
+		new web3._extend.Method({
+			name: 'filter',
+			call: 'trace_filter',
+			params: 1,
+		}),
 	],
 });
 `
@@ -68,3 +68,11 @@ func (miner *Miner) preconfLoop() {
 func (miner *Miner) IsPreconfStatusOk() bool {
 	return miner.preconfChecker.PrecheckStatus() == nil
 }
+
+// PendingL1FeeParams returns the fee oracle values predicted for the block on
+// top of the current head. The prediction is taken from the preconf env, which
+// has the known pending deposits applied. It returns nil if the preconf checker
+// has no prediction, in which case the values of the head are the best guess.
+func (miner *Miner) PendingL1FeeParams() *types.L1FeeParams {
+	return miner.preconfChecker.PredictedL1FeeParams(miner.chain.CurrentHeader().Number.Uint64() + 1)
+}
@@ -95,8 +95,12 @@ type Payload struct {
 	requests      [][]byte
 	fullFees      *big.Int
 	stop          chan struct{}
-	lock          sync.Mutex
-	cond          *sync.Cond
+
+	emptyForcedTxs []*engine.ForcedTransactionResult // Execution report of the forced transactions in the empty block
+	fullForcedTxs  []*engine.ForcedTransactionResult // Execution report of the forced transactions in the full block
+
+	lock sync.Mutex
+	cond *sync.Cond
 }
 
 // newPayload initializes the payload object.
@@ -132,6 +136,7 @@ func (payload *Payload) update(r *newPayloadResult, elapsed time.Duration) {
 		payload.sidecars = r.sidecars
 		payload.requests = r.requests
 		payload.fullWitness = r.witness
+		payload.fullForcedTxs = r.forcedTxs
 
 		feesInEther := new(big.Float).Quo(new(big.Float).SetInt(r.fees), big.NewFloat(params.Ether))
 		log.Info("Updated payload",
@@ -245,12 +250,14 @@ func (miner *Miner) buildPayload(args *BuildPayloadArgs, witness bool) (*Payload
 	}
 	// Construct a payload object for return.
 	payload := newPayload(empty.block, empty.requests, empty.witness, args.Id())
+	payload.emptyForcedTxs = empty.forcedTxs
 
 	if args.NoTxPool { // don't start the background payload updating job if there is no tx pool to pull from
 		// make sure to make it appear as full, otherwise it will wait indefinitely for payload building to complete.
 		payload.full = empty.block
 		payload.fullFees = empty.fees
 		payload.fullWitness = empty.witness
+		payload.fullForcedTxs = empty.forcedTxs
 		payload.requests = empty.requests
 		return payload, nil
 	}
@@ -0,0 +1,65 @@
+package miner
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ForcedTxError is returned when building a payload fails because one of the
+// transactions forced into it via the engine API could not be included. It
+// carries the execution report of all the forced transactions.
+type ForcedTxError struct {
+	Tx     *types.Transaction
+	From   common.Address
+	Err    error
+	Report []*engine.ForcedTransactionResult
+}
+
+func (e *ForcedTxError) Error() string {
+	return fmt.Sprintf("failed to force-include tx: %s type: %d sender: %s nonce: %d, err: %v", e.Tx.Hash(), e.Tx.Type(), e.From, e.Tx.Nonce(), e.Err)
+}
+
+func (e *ForcedTxError) Unwrap() error {
+	return e.Err
+}
+
+// newForcedTxResult creates the execution report entry of a forced transaction,
+// pending its execution outcome.
+func newForcedTxResult(index int, tx *types.Transaction, signer types.Signer) *engine.ForcedTransactionResult {
+	from, _ := types.Sender(signer, tx)
+	return &engine.ForcedTransactionResult{
+		Index: hexutil.Uint64(index),
+		Hash:  tx.Hash(),
+		Type:  hexutil.Uint64(tx.Type()),
+		From:  from,
+		Nonce: hexutil.Uint64(tx.Nonce()),
+		Gas:   hexutil.Uint64(tx.Gas()),
+	}
+}
+
+// Debug returns the execution report of the transactions forced into the
+// latest built version of the payload. Unlike Resolve, it does not interrupt
+// the payload building.
+func (payload *Payload) Debug() *engine.PayloadDebugV1 {
+	payload.lock.Lock()
+	defer payload.lock.Unlock()
+
+	block, forced := payload.empty, payload.emptyForcedTxs
+	if payload.full != nil {
+		block, forced = payload.full, payload.fullForcedTxs
+	}
+	number, gasUsed, hash := hexutil.Uint64(block.NumberU64()), hexutil.Uint64(block.GasUsed()), block.Hash()
+	return &engine.PayloadDebugV1{
+		PayloadID:          payload.id,
+		ParentHash:         block.ParentHash(),
+		Timestamp:          hexutil.Uint64(block.Time()),
+		Number:             &number,
+		BlockHash:          &hash,
+		GasUsed:            &gasUsed,
+		ForcedTransactions: forced,
+	}
+}
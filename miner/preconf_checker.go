@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -65,6 +66,16 @@ type preconfChecker struct {
 	// need pre apply to env
 	depositTxs           []*types.Transaction
 	unSealedPreconfTxsCh chan []*types.Transaction
+
+	// fee oracle values of the env after applying the deposit txs
+	l1FeeParams atomic.Pointer[predictedL1FeeParams]
+}
+
+// predictedL1FeeParams are the fee oracle values predicted for a future block
+// from the known pending deposits.
+type predictedL1FeeParams struct {
+	number uint64
+	params *types.L1FeeParams
 }
 
 type updateDepositTxsHeader struct {
@@ -449,6 +460,17 @@ func applyPreconfTransaction(evm *vm.EVM, gp *core.GasPool, statedb *state.State
 	return receipt, result.Revert(), nil
 }
 
+// PredictedL1FeeParams returns the fee oracle values predicted for the block
+// with the given number from the known pending deposits, or nil if there is no
+// prediction for the block.
+func (c *preconfChecker) PredictedL1FeeParams(number uint64) *types.L1FeeParams {
+	predicted := c.l1FeeParams.Load()
+	if predicted == nil || predicted.number != number {
+		return nil
+	}
+	return predicted.params
+}
+
 func (c *preconfChecker) PausePreconf() chan<- []*types.Transaction {
 	c.mu.Lock()
 
@@ -486,6 +508,15 @@ func (c *preconfChecker) UnpausePreconf(env *environment, preconfReady func()) {
 		log.Trace("applied deposit tx", "tx", tx.Hash().Hex(), "nonce", tx.Nonce())
 	}
 
+	// The oracles are updated by deposits only, record their values as the
+	// prediction for the env block.
+	if c.minerConfig.EnablePreconfChecker && c.optimismSyncStatusOk {
+		c.l1FeeParams.Store(&predictedL1FeeParams{
+			number: c.env.header.Number.Uint64(),
+			params: types.ReadL1FeeParams(c.env.state),
+		})
+	}
+
 	// Load unsealed preconf txs
 	var unsealedPreconfTxs []*types.Transaction
 	select {
@@ -2,12 +2,14 @@ package miner
 
 import (
 	"context"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/preconf"
 )
@@ -438,3 +440,28 @@ func TestUpdateOptimismSyncStatusDelay(t *testing.T) {
 		})
 	}
 }
+
+// Tests that the fee oracle values of the preconf env are recorded as the
+// prediction for the next block when preconfirmations resume.
+func TestPredictedL1FeeParams(t *testing.T) {
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	statedb.SetState(types.GasOracleAddr, types.TokenRatioSlot, common.BigToHash(big.NewInt(3)))
+
+	checker := &preconfChecker{
+		minerConfig:          &preconf.MinerConfig{EnablePreconfChecker: true},
+		optimismSyncStatusOk: true,
+	}
+	if fees := checker.PredictedL1FeeParams(11); fees != nil {
+		t.Fatalf("unexpected prediction before the env is set: %v", fees)
+	}
+	checker.PausePreconf() <- nil
+	checker.UnpausePreconf(&environment{state: statedb, header: &types.Header{Number: big.NewInt(10)}}, func() {})
+
+	fees := checker.PredictedL1FeeParams(11)
+	if fees == nil || fees.TokenRatio.Cmp(big.NewInt(3)) != 0 {
+		t.Fatalf("token ratio mismatch: have %v, want 3", fees)
+	}
+	if fees := checker.PredictedL1FeeParams(12); fees != nil {
+		t.Fatalf("unexpected prediction for a later block: %v", fees)
+	}
+}
@@ -24,7 +24,9 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
@@ -136,6 +138,8 @@ type newPayloadResult struct {
 	receipts []*types.Receipt       // Receipts collected during construction
 	requests [][]byte               // Consensus layer requests collected during block construction
 	witness  *stateless.Witness     // Witness is an optional stateless proof
+
+	forcedTxs []*engine.ForcedTransactionResult // Execution report of the transactions forced via engine API
 }
 
 // generateParams wraps various settings for generating sealing task.
@@ -186,12 +190,28 @@ func (miner *Miner) generateWork(genParam *generateParams, witness bool) *newPay
 		work.gasPool = new(core.GasPool).AddGas(gasLimit)
 	}
 
-	for _, tx := range genParam.txs {
-		from, _ := types.Sender(work.signer, tx)
+	forcedTxs := make([]*engine.ForcedTransactionResult, 0, len(genParam.txs))
+	for i, tx := range genParam.txs {
+		result := newForcedTxResult(i, tx, work.signer)
+		forcedTxs = append(forcedTxs, result)
+
 		work.state.SetTxContext(tx.Hash(), work.tcount)
 		err = miner.commitTransaction(work, tx)
 		if err != nil {
-			return &newPayloadResult{err: fmt.Errorf("failed to force-include tx: %s type: %d sender: %s nonce: %d, err: %w", tx.Hash(), tx.Type(), from, tx.Nonce(), err)}
+			result.Status, result.Error = engine.ForcedTxRejected, err.Error()
+			for j, skipped := range genParam.txs[i+1:] {
+				skippedResult := newForcedTxResult(i+1+j, skipped, work.signer)
+				skippedResult.Status = engine.ForcedTxSkipped
+				forcedTxs = append(forcedTxs, skippedResult)
+			}
+			return &newPayloadResult{err: &ForcedTxError{Tx: tx, From: result.From, Err: err, Report: forcedTxs}}
+		}
+		receipt := work.receipts[len(work.receipts)-1]
+		result.GasUsed = hexutil.Uint64(receipt.GasUsed)
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			result.Status = engine.ForcedTxSuccess
+		} else {
+			result.Status = engine.ForcedTxReverted
 		}
 	}
 
@@ -248,13 +268,14 @@ func (miner *Miner) generateWork(genParam *generateParams, witness bool) *newPay
 		return &newPayloadResult{err: err}
 	}
 	return &newPayloadResult{
-		block:    block,
-		fees:     totalFees(block, work.receipts),
-		sidecars: work.sidecars,
-		stateDB:  work.state,
-		receipts: work.receipts,
-		requests: requests,
-		witness:  work.witness,
+		block:     block,
+		fees:      totalFees(block, work.receipts),
+		sidecars:  work.sidecars,
+		stateDB:   work.state,
+		receipts:  work.receipts,
+		requests:  requests,
+		witness:   work.witness,
+		forcedTxs: forcedTxs,
 	}
 }
 
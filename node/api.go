@@ -18,6 +18,7 @@ package node
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -181,6 +182,9 @@ func (api *adminAPI) StartHTTP(host *string, port *int, cors *string, apis *stri
 		rpcEndpointConfig: rpcEndpointConfig{
 			batchItemLimit:         api.node.config.BatchRequestLimit,
 			batchResponseSizeLimit: api.node.config.BatchResponseMaxSize,
+			rateLimiter:            api.node.rateLimiter,
+			apiKeys:                api.node.apiKeys,
+			responseCache:          api.node.responseCache,
 		},
 	}
 	if cors != nil {
@@ -259,6 +263,9 @@ func (api *adminAPI) StartWS(host *string, port *int, allowedOrigins *string, ap
 		rpcEndpointConfig: rpcEndpointConfig{
 			batchItemLimit:         api.node.config.BatchRequestLimit,
 			batchResponseSizeLimit: api.node.config.BatchResponseMaxSize,
+			rateLimiter:            api.node.rateLimiter,
+			apiKeys:                api.node.apiKeys,
+			responseCache:          api.node.responseCache,
 		},
 	}
 	if apis != nil {
@@ -297,6 +304,35 @@ func (api *adminAPI) StopWS() (bool, error) {
 	return true, nil
 }
 
+// SetRPCRateLimits replaces the rate and concurrency limits of the RPC methods
+// served over HTTP and WebSocket.
+func (api *adminAPI) SetRPCRateLimits(limits rpc.RateLimits) (bool, error) {
+	if err := api.node.rateLimiter.SetLimits(limits); err != nil {
+		return false, err
+	}
+	api.node.lock.Lock()
+	api.node.config.RPCRateLimits = limits
+	api.node.lock.Unlock()
+	return true, nil
+}
+
+// RPCRateLimits retrieves the rate and concurrency limits of the RPC methods.
+func (api *adminAPI) RPCRateLimits() rpc.RateLimits {
+	return api.node.rateLimiter.Limits()
+}
+
+// ReloadRPCAPIKeys loads the API keys authenticating the HTTP and WebSocket
+// requests from the key file again.
+func (api *adminAPI) ReloadRPCAPIKeys() (bool, error) {
+	if api.node.apiKeys == nil {
+		return false, errors.New("RPC API keys are not enabled")
+	}
+	if err := api.node.apiKeys.reload(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Peers retrieves all the information we know about each individual peer at the
 // protocol granularity.
 func (api *adminAPI) Peers() ([]*p2p.PeerInfo, error) {
@@ -0,0 +1,307 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// apiKeyHeader is the header carrying the API key of the HTTP and WebSocket
+// requests. The key may be passed as the last segment of the URL path instead.
+const apiKeyHeader = "X-API-Key"
+
+var (
+	errMissingAPIKey = errors.New("missing API key")
+	errInvalidAPIKey = errors.New("invalid API key")
+)
+
+// apiKeyFile is the content of the API key file.
+type apiKeyFile struct {
+	// Public is the policy of the requests without a key. These requests are
+	// rejected if unset.
+	Public *apiKeyPolicy `json:"public"`
+
+	// RateClasses holds the rate limits of the keys, keyed by the class name.
+	// The limits of a class apply to each of its keys separately.
+	RateClasses map[string]rpc.RateLimits `json:"rateClasses"`
+
+	Keys []apiKeyConfig `json:"keys"`
+}
+
+// apiKeyPolicy restricts the calls made with a key.
+type apiKeyPolicy struct {
+	// Methods allowed to call, either method names, namespace wildcards such
+	// as "eth_*" or the catch-all "*".
+	Methods []string `json:"methods"`
+
+	// RateClass is the name of the rate limits applying to the key, the rate
+	// limits of the node are applied if empty.
+	RateClass string `json:"rateClass"`
+}
+
+// apiKeyConfig is an API key entry of the key file.
+type apiKeyConfig struct {
+	Name string `json:"name"` // Name identifying the client in the metrics and the rate limits
+	Key  string `json:"key"`
+	apiKeyPolicy
+}
+
+// apiKeyClient is a client of the RPC endpoints, authenticated by its key.
+type apiKeyClient struct {
+	name         string
+	policy       *rpc.ClientPolicy
+	unrestricted bool // Whether all methods are allowed
+
+	requestMeter *metrics.Meter // HTTP requests and WebSocket connections
+	callMeter    *metrics.Meter // RPC method calls
+	deniedMeter  *metrics.Meter // RPC method calls rejected by the allowlist
+}
+
+// apiKeySet holds the clients loaded from the key file.
+type apiKeySet struct {
+	public *apiKeyClient              // nil if requests without a key are rejected
+	keys   map[[32]byte]*apiKeyClient // keyed by the hash of the key
+}
+
+// apiKeyStore authenticates the requests with the API keys of a file. The
+// file can be reloaded while serving requests.
+type apiKeyStore struct {
+	path string
+	set  atomic.Pointer[apiKeySet]
+}
+
+// newAPIKeyStore creates a store with the keys of the given file.
+func newAPIKeyStore(path string) (*apiKeyStore, error) {
+	s := &apiKeyStore{path: path}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// reload loads the keys from the file again. The previous keys are kept if the
+// file is invalid.
+func (s *apiKeyStore) reload() error {
+	blob, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read API key file: %w", err)
+	}
+	var file apiKeyFile
+	if err := json.Unmarshal(blob, &file); err != nil {
+		return fmt.Errorf("invalid API key file %s: %w", s.path, err)
+	}
+	set, err := file.build()
+	if err != nil {
+		return fmt.Errorf("invalid API key file %s: %w", s.path, err)
+	}
+	s.set.Store(set)
+	log.Info("Loaded RPC API keys", "path", s.path, "keys", len(set.keys), "public", set.public != nil)
+	return nil
+}
+
+// client returns the client authenticated by the given key.
+func (s *apiKeyStore) client(key string) (*apiKeyClient, error) {
+	set := s.set.Load()
+	if key == "" {
+		if set.public == nil {
+			return nil, errMissingAPIKey
+		}
+		return set.public, nil
+	}
+	client, ok := set.keys[sha256.Sum256([]byte(key))]
+	if !ok {
+		return nil, errInvalidAPIKey
+	}
+	return client, nil
+}
+
+// build validates the key file, creating the clients of the keys.
+func (file *apiKeyFile) build() (*apiKeySet, error) {
+	for class, limits := range file.RateClasses {
+		if _, err := rpc.NewRateLimiter(limits); err != nil {
+			return nil, fmt.Errorf("rate class %s: %w", class, err)
+		}
+	}
+	newClient := func(name string, policy *apiKeyPolicy) (*apiKeyClient, error) {
+		if len(policy.Methods) == 0 {
+			return nil, errors.New("no methods allowed")
+		}
+		// Every key gets a limiter of its own, the keys of a class don't share
+		// their limits.
+		var limiter *rpc.RateLimiter
+		if policy.RateClass != "" {
+			limits, ok := file.RateClasses[policy.RateClass]
+			if !ok {
+				return nil, fmt.Errorf("unknown rate class %s", policy.RateClass)
+			}
+			limiter, _ = rpc.NewRateLimiter(limits)
+		}
+		prefix := "rpc/apikey/" + name
+		if name == "" {
+			prefix = "rpc/apikey/public"
+		}
+		client := &apiKeyClient{
+			name:         name,
+			unrestricted: slices.Contains(policy.Methods, "*"),
+			requestMeter: metrics.GetOrRegisterMeter(prefix+"/requests", nil),
+			callMeter:    metrics.GetOrRegisterMeter(prefix+"/calls", nil),
+			deniedMeter:  metrics.GetOrRegisterMeter(prefix+"/denied", nil),
+		}
+		allowed := newMethodAllowlist(policy.Methods)
+		client.policy = &rpc.ClientPolicy{
+			AllowMethod: func(method string) bool {
+				if !allowed(method) {
+					client.deniedMeter.Mark(1)
+					return false
+				}
+				client.callMeter.Mark(1)
+				return true
+			},
+			RateLimiter: limiter,
+		}
+		return client, nil
+	}
+	set := &apiKeySet{keys: make(map[[32]byte]*apiKeyClient)}
+	if file.Public != nil {
+		client, err := newClient("", file.Public)
+		if err != nil {
+			return nil, fmt.Errorf("public policy: %w", err)
+		}
+		set.public = client
+	}
+	names := make(map[string]bool)
+	for i, entry := range file.Keys {
+		switch {
+		case entry.Name == "" || entry.Name == "public" || strings.ContainsAny(entry.Name, "/ "):
+			return nil, fmt.Errorf("key %d: invalid name %q", i, entry.Name)
+		case names[entry.Name]:
+			return nil, fmt.Errorf("key %d: duplicate name %s", i, entry.Name)
+		case entry.Key == "" || strings.Contains(entry.Key, "/"):
+			return nil, fmt.Errorf("key %s: invalid key", entry.Name)
+		}
+		hash := sha256.Sum256([]byte(entry.Key))
+		if _, ok := set.keys[hash]; ok {
+			return nil, fmt.Errorf("key %s: duplicate key", entry.Name)
+		}
+		client, err := newClient(entry.Name, &entry.apiKeyPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", entry.Name, err)
+		}
+		names[entry.Name] = true
+		set.keys[hash] = client
+	}
+	return set, nil
+}
+
+// newMethodAllowlist returns a function reporting whether a method matches
+// the given method names and wildcards.
+func newMethodAllowlist(patterns []string) func(method string) bool {
+	var (
+		methods    = make(map[string]bool)
+		namespaces = make(map[string]bool)
+		all        bool
+	)
+	for _, pattern := range patterns {
+		switch {
+		case pattern == "*":
+			all = true
+		case strings.HasSuffix(pattern, "_*"):
+			namespaces[strings.TrimSuffix(pattern, "*")] = true
+		default:
+			methods[pattern] = true
+		}
+	}
+	return func(method string) bool {
+		if all || methods[method] {
+			return true
+		}
+		if i := strings.IndexByte(method, '_'); i >= 0 {
+			return namespaces[method[:i+1]]
+		}
+		return false
+	}
+}
+
+// apiKeyHandler is an http.Handler authenticating the requests with API keys.
+type apiKeyHandler struct {
+	keys         *apiKeyStore
+	next         http.Handler
+	unrestricted bool // Whether the handler is only served to clients allowed to call all methods
+}
+
+// newAPIKeyHandler wraps the RPC handler with API key authentication.
+func newAPIKeyHandler(keys *apiKeyStore, next http.Handler) http.Handler {
+	return &apiKeyHandler{keys: keys, next: next}
+}
+
+// newAPIKeyMuxHandler wraps a handler registered through Node.RegisterHandler,
+// such as GraphQL, with API key authentication. The method allowlists cannot
+// be enforced on these handlers, so they are only served to the clients which
+// are allowed to call all methods.
+func newAPIKeyMuxHandler(keys *apiKeyStore, next http.Handler) http.Handler {
+	return &apiKeyHandler{keys: keys, next: next, unrestricted: true}
+}
+
+// ServeHTTP implements http.Handler
+func (handler *apiKeyHandler) ServeHTTP(out http.ResponseWriter, r *http.Request) {
+	client, err := handler.keys.client(r.Header.Get(apiKeyHeader))
+	if err != nil {
+		http.Error(out, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if handler.unrestricted && !client.unrestricted {
+		client.deniedMeter.Mark(1)
+		http.Error(out, "endpoint not allowed", http.StatusForbidden)
+		return
+	}
+	client.requestMeter.Mark(1)
+
+	ctx := rpc.NewContextWithClientPolicy(r.Context(), client.policy)
+	if client.name != "" {
+		ctx = rpc.NewContextWithClientID(ctx, client.name)
+	}
+	handler.next.ServeHTTP(out, r.WithContext(ctx))
+}
+
+// withPathAPIKey moves the API key passed as the last segment of the URL path,
+// i.e. <prefix>/<key>, into the API key header.
+func withPathAPIKey(r *http.Request, prefix string) *http.Request {
+	key, ok := strings.CutPrefix(r.URL.Path, strings.TrimSuffix(prefix, "/")+"/")
+	if !ok || key == "" || strings.Contains(key, "/") {
+		return r
+	}
+	r = r.Clone(r.Context())
+	r.URL.Path = prefix
+	if prefix == "" {
+		r.URL.Path = "/"
+	}
+	r.URL.RawPath = ""
+	r.Header.Set(apiKeyHeader, key)
+	return r
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testAPIKeys = `{
+	"public": {"methods": ["rpc_*"]},
+	"rateClasses": {
+		"tight": {"test_greet": {"rate": 0.001, "burst": 1}}
+	},
+	"keys": [
+		{"name": "partner", "key": "secret", "methods": ["rpc_modules", "test_*"]},
+		{"name": "limited", "key": "limited", "methods": ["*"], "rateClass": "tight"},
+		{"name": "other", "key": "other", "methods": ["*"], "rateClass": "tight"}
+	]
+}`
+
+// rpcResponseCode returns the error code of the JSON-RPC response, 0 on success.
+func rpcResponseCode(t *testing.T, resp *http.Response) int {
+	t.Helper()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response status %d", resp.StatusCode)
+	}
+	var msg struct {
+		Error *struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&msg); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if msg.Error == nil {
+		return 0
+	}
+	return msg.Error.Code
+}
+
+func TestAPIKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "apikeys.json")
+	if err := os.WriteFile(path, []byte(testAPIKeys), 0600); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := newAPIKeyStore(path)
+	if err != nil {
+		t.Fatalf("failed to load keys: %v", err)
+	}
+	cfg := rpcEndpointConfig{apiKeys: keys}
+	srv := createAndStartServer(t, &httpConfig{rpcEndpointConfig: cfg}, true, &wsConfig{Origins: []string{"*"}, rpcEndpointConfig: cfg}, nil)
+	defer srv.stop()
+	srv.mux.Handle("/graphql", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	var (
+		url   = fmt.Sprintf("http://%v", srv.listenAddr())
+		wsURL = fmt.Sprintf("ws://%v", srv.listenAddr())
+	)
+	// Requests without a key are limited to the public methods.
+	if code := rpcResponseCode(t, rpcRequest(t, url, "rpc_modules")); code != 0 {
+		t.Fatalf("public call failed with code %d", code)
+	}
+	if code := rpcResponseCode(t, rpcRequest(t, url, "test_greet")); code != -32601 {
+		t.Fatalf("expected public call to be denied, got code %d", code)
+	}
+	// Keys are accepted in the header and in the URL path.
+	if code := rpcResponseCode(t, rpcRequest(t, url, "test_greet", apiKeyHeader, "secret")); code != 0 {
+		t.Fatalf("call with header key failed with code %d", code)
+	}
+	if code := rpcResponseCode(t, rpcRequest(t, url+"/secret", "test_greet")); code != 0 {
+		t.Fatalf("call with path key failed with code %d", code)
+	}
+	if resp := rpcRequest(t, url, "rpc_modules", apiKeyHeader, "wrong"); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected invalid key to be rejected, got status %d", resp.StatusCode)
+	}
+	if err := wsRequest(t, wsURL, apiKeyHeader, "secret"); err != nil {
+		t.Fatalf("websocket with key failed: %v", err)
+	}
+	if err := wsRequest(t, wsURL+"/wrong"); err == nil {
+		t.Fatal("expected websocket with invalid key to be rejected")
+	}
+	// Handlers registered on the mux are only served to unrestricted keys.
+	for _, test := range []struct {
+		key    string
+		status int
+	}{
+		{"", http.StatusForbidden},
+		{"wrong", http.StatusUnauthorized},
+		{"secret", http.StatusForbidden},
+		{"limited", http.StatusOK},
+	} {
+		resp := rpcRequest(t, url+"/graphql", "rpc_modules", apiKeyHeader, test.key)
+		if resp.StatusCode != test.status {
+			t.Fatalf("key %q: unexpected status of mux handler, want %d, got %d", test.key, test.status, resp.StatusCode)
+		}
+	}
+	// The rate class of the key applies in place of the node limits.
+	if code := rpcResponseCode(t, rpcRequest(t, url, "test_greet", apiKeyHeader, "limited")); code != 0 {
+		t.Fatalf("limited call failed with code %d", code)
+	}
+	if code := rpcResponseCode(t, rpcRequest(t, url, "test_greet", apiKeyHeader, "limited")); code != -32005 {
+		t.Fatalf("expected limited call to be rejected, got code %d", code)
+	}
+	// The keys of a class are limited separately.
+	if code := rpcResponseCode(t, rpcRequest(t, url, "test_greet", apiKeyHeader, "other")); code != 0 {
+		t.Fatalf("call with other key of the class failed with code %d", code)
+	}
+	// Invalid files should be rejected, keeping the previous keys.
+	if err := os.WriteFile(path, []byte(`{"keys": [{"name": "partner", "key": "secret"}]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := keys.reload(); err == nil {
+		t.Fatal("expected key without methods to be rejected")
+	}
+	// Reloading should drop the public access.
+	if err := os.WriteFile(path, []byte(`{"keys": [{"name": "partner", "key": "secret", "methods": ["test_*"]}]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := keys.reload(); err != nil {
+		t.Fatalf("failed to reload keys: %v", err)
+	}
+	if resp := rpcRequest(t, url, "rpc_modules"); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected missing key to be rejected, got status %d", resp.StatusCode)
+	}
+	if code := rpcResponseCode(t, rpcRequest(t, url, "test_greet", apiKeyHeader, "secret")); code != 0 {
+		t.Fatalf("call after reload failed with code %d", code)
+	}
+}
@@ -204,6 +204,21 @@ type Config struct {
 	// BatchResponseMaxSize is the maximum number of bytes returned from a batched rpc call.
 	BatchResponseMaxSize int `toml:",omitempty"`
 
+	// RPCRateLimits are the rate and concurrency limits of the RPC methods served
+	// over HTTP and WebSocket, keyed by the method name or namespace wildcard.
+	RPCRateLimits rpc.RateLimits `toml:",omitempty"`
+
+	// RPCAPIKeysFile is the path of the API key file authenticating the HTTP
+	// and WebSocket requests. Each key restricts the methods it may call. The
+	// other HTTP handlers, e.g. GraphQL, are only served to the keys allowed
+	// to call all methods.
+	RPCAPIKeysFile string `toml:",omitempty"`
+
+	// RPCResponseCacheSize is the maximum number of bytes of immutable responses,
+	// such as the data of finalized blocks, cached by the HTTP and WebSocket
+	// servers. The cache is disabled if zero.
+	RPCResponseCacheSize int `toml:",omitempty"`
+
 	// JWTSecret is the path to the hex-encoded jwt secret.
 	JWTSecret string `toml:",omitempty"`
 
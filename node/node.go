@@ -67,6 +67,11 @@ type Node struct {
 	inprocHandler *rpc.Server // In-process RPC request handler to process the API requests
 
 	databases map[*closeTrackingDB]struct{} // All open databases
+
+	rateLimiter   *rpc.RateLimiter   // Limits of the RPC methods, shared by the HTTP and WS servers
+	apiKeys       *apiKeyStore       // API keys authenticating the HTTP and WS requests, nil if disabled
+	responseCache *rpc.ResponseCache // Cache of the immutable HTTP and WS responses, nil if disabled
+	rpcFallback   rpc.Fallback       // Fallback of the calls the node cannot serve, nil if disabled
 }
 
 const (
@@ -105,9 +110,14 @@ func New(conf *Config) (*Node, error) {
 	}
 	server := rpc.NewServer()
 	server.SetBatchLimits(conf.BatchRequestLimit, conf.BatchResponseMaxSize)
+	limiter, err := rpc.NewRateLimiter(conf.RPCRateLimits)
+	if err != nil {
+		return nil, err
+	}
 	node := &Node{
 		config:        conf,
 		inprocHandler: server,
+		rateLimiter:   limiter,
 		eventmux:      new(event.TypeMux),
 		log:           conf.Logger,
 		stop:          make(chan struct{}),
@@ -115,6 +125,15 @@ func New(conf *Config) (*Node, error) {
 		databases:     make(map[*closeTrackingDB]struct{}),
 	}
 
+	if conf.RPCAPIKeysFile != "" {
+		if node.apiKeys, err = newAPIKeyStore(conf.RPCAPIKeysFile); err != nil {
+			return nil, err
+		}
+	}
+	if conf.RPCResponseCacheSize > 0 {
+		node.responseCache = rpc.NewResponseCache(conf.RPCResponseCacheSize)
+	}
+
 	// Register built-in APIs.
 	node.rpcAPIs = append(node.rpcAPIs, node.apis()...)
 
@@ -381,7 +400,7 @@ func (n *Node) startRPC() error {
 
 	// Configure IPC.
 	if n.ipc.endpoint != "" {
-		if err := n.ipc.start(n.rpcAPIs); err != nil {
+		if err := n.ipc.start(n.rpcAPIs, n.rpcFallback); err != nil {
 			return err
 		}
 	}
@@ -393,6 +412,10 @@ func (n *Node) startRPC() error {
 	rpcConfig := rpcEndpointConfig{
 		batchItemLimit:         n.config.BatchRequestLimit,
 		batchResponseSizeLimit: n.config.BatchResponseMaxSize,
+		rateLimiter:            n.rateLimiter,
+		apiKeys:                n.apiKeys,
+		responseCache:          n.responseCache,
+		fallback:               n.rpcFallback,
 	}
 
 	initHttp := func(server *httpServer, port int) error {
@@ -440,6 +463,7 @@ func (n *Node) startRPC() error {
 			batchItemLimit:         engineAPIBatchItemLimit,
 			batchResponseSizeLimit: engineAPIBatchResponseSizeLimit,
 			httpBodyLimit:          engineAPIBodyLimit,
+			fallback:               n.rpcFallback,
 		}
 		err := server.enableRPC(allAPIs, httpConfig{
 			CorsAllowedOrigins: DefaultAuthCors,
@@ -579,6 +603,20 @@ func (n *Node) RegisterAPIs(apis []rpc.API) {
 	n.rpcAPIs = append(n.rpcAPIs, apis...)
 }
 
+// SetRPCFallback sets the fallback serving the calls which the node cannot serve
+// itself, such as the calls depending on the state of pre-Bedrock blocks, on all
+// RPC endpoints.
+func (n *Node) SetRPCFallback(fallback rpc.Fallback) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.state != initializingState {
+		panic("can't set the RPC fallback on running/stopped node")
+	}
+	n.rpcFallback = fallback
+	n.inprocHandler.SetFallback(fallback)
+}
+
 // getAPIs return two sets of APIs, both the ones that do not require
 // authentication, and the complete set
 func (n *Node) getAPIs() (unauthenticated, all []rpc.API) {
@@ -627,6 +665,12 @@ func (n *Node) Config() *Config {
 	return n.config
 }
 
+// RPCResponseCache returns the cache of the immutable responses of the HTTP and
+// WebSocket servers, nil if disabled.
+func (n *Node) RPCResponseCache() *rpc.ResponseCache {
+	return n.responseCache
+}
+
 // Server retrieves the currently running P2P network layer. This method is meant
 // only to inspect fields of the currently running server. Callers should not
 // start or stop the returned server.
@@ -58,12 +58,17 @@ type rpcEndpointConfig struct {
 	batchItemLimit         int
 	batchResponseSizeLimit int
 	httpBodyLimit          int
+	rateLimiter            *rpc.RateLimiter   // optional limits of the RPC methods
+	apiKeys                *apiKeyStore       // optional API key authentication
+	responseCache          *rpc.ResponseCache // optional cache of the immutable responses
+	fallback               rpc.Fallback       // optional fallback of the calls the node cannot serve
 }
 
 type rpcHandler struct {
 	http.Handler
-	prefix string
-	server *rpc.Server
+	prefix  string
+	server  *rpc.Server
+	apiKeys *apiKeyStore // nil if the requests are not authenticated by API keys
 }
 
 type httpServer struct {
@@ -198,6 +203,9 @@ func (h *httpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// check if ws request and serve if ws enabled
 	ws := h.wsHandler.Load()
 	if ws != nil && isWebsocket(r) {
+		if ws.apiKeys != nil {
+			r = withPathAPIKey(r, ws.prefix)
+		}
 		if checkPath(r, ws.prefix) {
 			ws.ServeHTTP(w, r)
 		}
@@ -213,10 +221,16 @@ func (h *httpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		// These are made available when RPC is enabled.
 		muxHandler, pattern := h.mux.Handler(r)
 		if pattern != "" {
+			if rpc.apiKeys != nil {
+				muxHandler = newAPIKeyMuxHandler(rpc.apiKeys, muxHandler)
+			}
 			muxHandler.ServeHTTP(w, r)
 			return
 		}
 
+		if rpc.apiKeys != nil {
+			r = withPathAPIKey(r, rpc.prefix)
+		}
 		if checkPath(r, rpc.prefix) {
 			rpc.ServeHTTP(w, r)
 			return
@@ -307,14 +321,28 @@ func (h *httpServer) enableRPC(apis []rpc.API, config httpConfig) error {
 	if config.httpBodyLimit > 0 {
 		srv.SetHTTPBodyLimit(config.httpBodyLimit)
 	}
+	if config.rateLimiter != nil {
+		srv.SetRateLimiter(config.rateLimiter)
+	}
+	if config.responseCache != nil {
+		srv.SetResponseCache(config.responseCache)
+	}
+	if config.fallback != nil {
+		srv.SetFallback(config.fallback)
+	}
 	if err := RegisterApis(apis, config.Modules, srv); err != nil {
 		return err
 	}
+	var handler http.Handler = srv
+	if config.apiKeys != nil {
+		handler = newAPIKeyHandler(config.apiKeys, handler)
+	}
 	h.httpConfig = config
 	h.httpHandler.Store(&rpcHandler{
-		Handler: NewHTTPHandlerStack(srv, config.CorsAllowedOrigins, config.Vhosts, config.jwtSecret),
+		Handler: NewHTTPHandlerStack(handler, config.CorsAllowedOrigins, config.Vhosts, config.jwtSecret),
 		prefix:  config.prefix,
 		server:  srv,
+		apiKeys: config.apiKeys,
 	})
 	return nil
 }
@@ -343,14 +371,28 @@ func (h *httpServer) enableWS(apis []rpc.API, config wsConfig) error {
 	if config.httpBodyLimit > 0 {
 		srv.SetHTTPBodyLimit(config.httpBodyLimit)
 	}
+	if config.rateLimiter != nil {
+		srv.SetRateLimiter(config.rateLimiter)
+	}
+	if config.responseCache != nil {
+		srv.SetResponseCache(config.responseCache)
+	}
+	if config.fallback != nil {
+		srv.SetFallback(config.fallback)
+	}
 	if err := RegisterApis(apis, config.Modules, srv); err != nil {
 		return err
 	}
+	handler := srv.WebsocketHandler(config.Origins)
+	if config.apiKeys != nil {
+		handler = newAPIKeyHandler(config.apiKeys, handler)
+	}
 	h.wsConfig = config
 	h.wsHandler.Store(&rpcHandler{
-		Handler: NewWSHandlerStack(srv.WebsocketHandler(config.Origins), config.jwtSecret),
+		Handler: NewWSHandlerStack(handler, config.jwtSecret),
 		prefix:  config.prefix,
 		server:  srv,
+		apiKeys: config.apiKeys,
 	})
 	return nil
 }
@@ -598,14 +640,18 @@ func newIPCServer(log log.Logger, endpoint string) *ipcServer {
 }
 
 // start starts the httpServer's http.Server
-func (is *ipcServer) start(apis []rpc.API) error {
+func (is *ipcServer) start(apis []rpc.API, fallback rpc.Fallback) error {
 	is.mu.Lock()
 	defer is.mu.Unlock()
 
 	if is.listener != nil {
 		return nil // already running
 	}
-	listener, srv, err := rpc.StartIPCEndpoint(is.endpoint, apis)
+	srv := rpc.NewServer()
+	if fallback != nil {
+		srv.SetFallback(fallback)
+	}
+	listener, err := rpc.ServeIPCEndpoint(is.endpoint, srv, apis)
 	if err != nil {
 		is.log.Warn("IPC opening failed", "url", is.endpoint, "error", err)
 		return err
@@ -449,6 +449,35 @@ func TestJWT(t *testing.T) {
 	srv.stop()
 }
 
+// TestJWTRateLimit checks that the clients authenticated by JWT are rate limited
+// by the subject of their tokens rather than by their address.
+func TestJWTRateLimit(t *testing.T) {
+	secret := []byte("secret")
+	limiter, err := rpc.NewRateLimiter(rpc.RateLimits{testMethod: {ClientRate: 0.001, ClientBurst: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := rpcEndpointConfig{jwtSecret: secret, rateLimiter: limiter}
+	srv := createAndStartServer(t, &httpConfig{rpcEndpointConfig: cfg}, false, &wsConfig{}, nil)
+	defer srv.stop()
+	url := fmt.Sprintf("http://%v", srv.listenAddr())
+
+	call := func(subject string) int {
+		claims := testClaim{"iat": time.Now().Unix(), "sub": subject}
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+		return rpcResponseCode(t, rpcRequest(t, url, testMethod, "Authorization", "Bearer "+token))
+	}
+	// Both subjects call from the same address, each within its own limit.
+	for _, subject := range []string{"alice", "bob"} {
+		if code := call(subject); code != 0 {
+			t.Fatalf("call of %s failed with code %d", subject, code)
+		}
+	}
+	if code := call("alice"); code != -32005 {
+		t.Fatalf("expected call over the limit to be rejected, got code %d", code)
+	}
+}
+
 func TestGzipHandler(t *testing.T) {
 	type gzipTest struct {
 		name    string
@@ -1156,6 +1156,34 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 		}
 	}
 
+	// Mantle forks are scheduled independently of the upstream ones, so they
+	// are checked as a separate sequence. The legacy upgrades and Everest may
+	// be left out, but Limb requires Skadi.
+	lastFork = fork{}
+	for _, cur := range []fork{
+		{name: "baseFeeTime", timestamp: c.BaseFeeTime, optional: true},
+		{name: "bvmETHMintUpgradeTime", timestamp: c.BVMETHMintUpgradeTime, optional: true},
+		{name: "metaTxV2UpgradeTime", timestamp: c.MetaTxV2UpgradeTime, optional: true},
+		{name: "metaTxV3UpgradeTime", timestamp: c.MetaTxV3UpgradeTime, optional: true},
+		{name: "mantleEverestTime", timestamp: c.MantleEverestTime, optional: true},
+		{name: "mantleSkadiTime", timestamp: c.MantleSkadiTime},
+		{name: "mantleLimbTime", timestamp: c.MantleLimbTime},
+	} {
+		if lastFork.name != "" {
+			switch {
+			case lastFork.timestamp == nil && cur.timestamp != nil:
+				return fmt.Errorf("unsupported fork ordering: %v not enabled, but %v enabled at timestamp %v",
+					lastFork.name, cur.name, *cur.timestamp)
+			case lastFork.timestamp != nil && cur.timestamp != nil && *lastFork.timestamp > *cur.timestamp:
+				return fmt.Errorf("unsupported fork ordering: %v enabled at timestamp %v, but %v enabled at timestamp %v",
+					lastFork.name, *lastFork.timestamp, cur.name, *cur.timestamp)
+			}
+		}
+		if !cur.optional || cur.timestamp != nil {
+			lastFork = cur
+		}
+	}
+
 	// OP-Stack chains don't support blobs, and must have a nil BlobScheduleConfig.
 	if c.IsOptimism() {
 		if c.BlobScheduleConfig == nil {
@@ -100,6 +100,93 @@ func GetUpgradeConfigForMantle(chainID *big.Int) *MantleUpgradeChainConfig {
 	}
 }
 
+// MantleFork is a named Mantle upgrade together with its activation time.
+type MantleFork struct {
+	Name string
+	Time *uint64 // nil = not scheduled
+}
+
+// MantleForks returns all Mantle upgrades in activation order, including the
+// ones that are not scheduled on this chain.
+func (c *ChainConfig) MantleForks() []MantleFork {
+	return []MantleFork{
+		{Name: "baseFee", Time: c.BaseFeeTime},
+		{Name: "bvmETHMint", Time: c.BVMETHMintUpgradeTime},
+		{Name: "metaTxV2", Time: c.MetaTxV2UpgradeTime},
+		{Name: "metaTxV3", Time: c.MetaTxV3UpgradeTime},
+		{Name: "proxyOwner", Time: c.ProxyOwnerUpgradeTime},
+		{Name: "everest", Time: c.MantleEverestTime},
+		{Name: "skadi", Time: c.MantleSkadiTime},
+		{Name: "limb", Time: c.MantleLimbTime},
+	}
+}
+
+// IsBundledMantleChain reports whether the given chain ID has an upgrade
+// schedule shipped with the client.
+func IsBundledMantleChain(chainID *big.Int) bool {
+	if chainID == nil {
+		return false
+	}
+	switch chainID.Int64() {
+	case MantleMainnetChainId.Int64(), MantleSepoliaChainId.Int64(), MantleSepoliaQA6ChainId.Int64(), MantleLocalChainId.Int64():
+		return true
+	default:
+		return false
+	}
+}
+
+// MantleUpgradeConfigFromChainConfig extracts the Mantle upgrade schedule
+// defined in the given chain config. It returns nil if the chain config does
+// not schedule any Mantle fork.
+func MantleUpgradeConfigFromChainConfig(c *ChainConfig) *MantleUpgradeChainConfig {
+	if c == nil {
+		return nil
+	}
+	cfg := &MantleUpgradeChainConfig{
+		ChainID:               c.ChainID,
+		BaseFeeTime:           c.BaseFeeTime,
+		BVMETHMintUpgradeTime: c.BVMETHMintUpgradeTime,
+		MetaTxV2UpgradeTime:   c.MetaTxV2UpgradeTime,
+		MetaTxV3UpgradeTime:   c.MetaTxV3UpgradeTime,
+		ProxyOwnerUpgradeTime: c.ProxyOwnerUpgradeTime,
+		MantleEverestTime:     c.MantleEverestTime,
+		MantleSkadiTime:       c.MantleSkadiTime,
+		MantleLimbTime:        c.MantleLimbTime,
+	}
+	if cfg.BaseFeeTime == nil && cfg.BVMETHMintUpgradeTime == nil && cfg.MetaTxV2UpgradeTime == nil &&
+		cfg.MetaTxV3UpgradeTime == nil && cfg.ProxyOwnerUpgradeTime == nil && cfg.MantleEverestTime == nil &&
+		cfg.MantleSkadiTime == nil && cfg.MantleLimbTime == nil {
+		return nil
+	}
+	return cfg.Copy()
+}
+
+// Copy returns a deep copy of the upgrade config, so that it can be modified
+// without affecting the bundled network configs.
+func (c *MantleUpgradeChainConfig) Copy() *MantleUpgradeChainConfig {
+	cpy := &MantleUpgradeChainConfig{
+		BaseFeeTime:           copyU64Ptr(c.BaseFeeTime),
+		BVMETHMintUpgradeTime: copyU64Ptr(c.BVMETHMintUpgradeTime),
+		MetaTxV2UpgradeTime:   copyU64Ptr(c.MetaTxV2UpgradeTime),
+		MetaTxV3UpgradeTime:   copyU64Ptr(c.MetaTxV3UpgradeTime),
+		ProxyOwnerUpgradeTime: copyU64Ptr(c.ProxyOwnerUpgradeTime),
+		MantleEverestTime:     copyU64Ptr(c.MantleEverestTime),
+		MantleSkadiTime:       copyU64Ptr(c.MantleSkadiTime),
+		MantleLimbTime:        copyU64Ptr(c.MantleLimbTime),
+	}
+	if c.ChainID != nil {
+		cpy.ChainID = new(big.Int).Set(c.ChainID)
+	}
+	return cpy
+}
+
+func copyU64Ptr(v *uint64) *uint64 {
+	if v == nil {
+		return nil
+	}
+	return u64Ptr(*v)
+}
+
 func u64Ptr(v uint64) *uint64 {
 	return &v
 }
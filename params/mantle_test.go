@@ -30,3 +30,96 @@ func TestGetUpgradeConfigForMantle(t *testing.T) {
 		t.Errorf("wrong baseFeeTime: got %v, want %v", *defaultUpgradeConfig.BaseFeeTime, *MantleDefaultUpgradeConfig.BaseFeeTime)
 	}
 }
+
+func TestMantleUpgradeConfigCopy(t *testing.T) {
+	cpy := MantleMainnetUpgradeConfig.Copy()
+	*cpy.MantleEverestTime = 0
+	cpy.ChainID.SetUint64(1)
+	if *MantleMainnetUpgradeConfig.MantleEverestTime == 0 {
+		t.Errorf("copy modified bundled everest time")
+	}
+	if MantleMainnetUpgradeConfig.ChainID.Cmp(MantleMainnetChainId) != 0 {
+		t.Errorf("copy modified bundled chain id")
+	}
+	if MantleUpgradeConfigFromChainConfig(&ChainConfig{ChainID: OtherChainID}) != nil {
+		t.Errorf("expected nil upgrade config for chain without mantle forks")
+	}
+	if cfg := MantleUpgradeConfigFromChainConfig(&ChainConfig{ChainID: OtherChainID, MantleSkadiTime: u64Ptr(10)}); cfg == nil || *cfg.MantleSkadiTime != 10 {
+		t.Errorf("wrong upgrade config extracted from chain config: %v", cfg)
+	}
+}
+
+func TestCheckMantleForkOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     MantleUpgradeChainConfig
+		wantErr bool
+	}{
+		{name: "mainnet", cfg: MantleMainnetUpgradeConfig},
+		{name: "sepolia", cfg: MantleSepoliaUpgradeConfig},
+		{name: "qa6", cfg: MantleSepoliaQA6UpgradeConfig},
+		{name: "default", cfg: MantleDefaultUpgradeConfig},
+		{name: "none", cfg: MantleUpgradeChainConfig{}},
+		{name: "skadi before everest", cfg: MantleUpgradeChainConfig{MantleEverestTime: u64Ptr(20), MantleSkadiTime: u64Ptr(10)}, wantErr: true},
+		{name: "limb without skadi", cfg: MantleUpgradeChainConfig{MantleEverestTime: u64Ptr(0), MantleLimbTime: u64Ptr(10)}, wantErr: true},
+		{name: "everest before metatx v3", cfg: MantleUpgradeChainConfig{MetaTxV3UpgradeTime: u64Ptr(20), MantleEverestTime: u64Ptr(10)}, wantErr: true},
+		{name: "skadi without everest", cfg: MantleUpgradeChainConfig{MantleSkadiTime: u64Ptr(0), MantleLimbTime: u64Ptr(0)}},
+		{name: "legacy upgrades omitted", cfg: MantleUpgradeChainConfig{MantleEverestTime: u64Ptr(10), MantleSkadiTime: u64Ptr(20)}},
+	}
+	for _, test := range tests {
+		cfg := &ChainConfig{
+			ChainID:               OtherChainID,
+			BaseFeeTime:           test.cfg.BaseFeeTime,
+			BVMETHMintUpgradeTime: test.cfg.BVMETHMintUpgradeTime,
+			MetaTxV2UpgradeTime:   test.cfg.MetaTxV2UpgradeTime,
+			MetaTxV3UpgradeTime:   test.cfg.MetaTxV3UpgradeTime,
+			ProxyOwnerUpgradeTime: test.cfg.ProxyOwnerUpgradeTime,
+			MantleEverestTime:     test.cfg.MantleEverestTime,
+			MantleSkadiTime:       test.cfg.MantleSkadiTime,
+			MantleLimbTime:        test.cfg.MantleLimbTime,
+		}
+		err := cfg.CheckConfigForkOrder()
+		if test.wantErr && err == nil {
+			t.Errorf("%s: expected fork ordering error", test.name)
+		}
+		if !test.wantErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
+
+func TestMantleForks(t *testing.T) {
+	cfg := &ChainConfig{
+		BaseFeeTime:           u64Ptr(0),
+		BVMETHMintUpgradeTime: u64Ptr(0),
+		MetaTxV2UpgradeTime:   u64Ptr(10),
+		MetaTxV3UpgradeTime:   u64Ptr(20),
+		MantleEverestTime:     u64Ptr(30),
+		MantleSkadiTime:       u64Ptr(30),
+	}
+	want := []struct {
+		name string
+		time *uint64
+	}{
+		{"baseFee", u64Ptr(0)},
+		{"bvmETHMint", u64Ptr(0)},
+		{"metaTxV2", u64Ptr(10)},
+		{"metaTxV3", u64Ptr(20)},
+		{"proxyOwner", nil},
+		{"everest", u64Ptr(30)},
+		{"skadi", u64Ptr(30)},
+		{"limb", nil},
+	}
+	forks := cfg.MantleForks()
+	if len(forks) != len(want) {
+		t.Fatalf("wrong number of forks: have %d, want %d", len(forks), len(want))
+	}
+	for i, fork := range forks {
+		if fork.Name != want[i].name {
+			t.Errorf("fork %d: wrong name: have %s, want %s", i, fork.Name, want[i].name)
+		}
+		if (fork.Time == nil) != (want[i].time == nil) || (fork.Time != nil && *fork.Time != *want[i].time) {
+			t.Errorf("fork %s: wrong time: have %v, want %v", fork.Name, fork.Time, want[i].time)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+)
+
+// ResponseCache caches the results of the calls returning immutable data, such
+// as the data of finalized blocks. Methods opt in by calling
+// MarkResponseImmutable on the context of the call. Only the methods which did
+// so are looked up in the cache, keyed by the method name and the canonical
+// encoding of the arguments.
+type ResponseCache struct {
+	maxSize uint64
+	cache   atomic.Pointer[lru.SizeConstrainedCache[string, json.RawMessage]]
+	methods sync.Map // names of the methods which returned immutable results
+}
+
+// NewResponseCache creates a cache holding at most maxSize bytes of results. A
+// single cache may be shared by multiple servers.
+func NewResponseCache(maxSize int) *ResponseCache {
+	c := &ResponseCache{maxSize: uint64(maxSize)}
+	c.Purge()
+	return c
+}
+
+// Purge drops all the cached results. This must be called if the data deemed
+// immutable has been changed, e.g. when the chain is rewound.
+func (c *ResponseCache) Purge() {
+	c.cache.Store(lru.NewSizeConstrainedCache[string, json.RawMessage](c.maxSize))
+}
+
+// responseCacheMarker tracks whether the result of a call is immutable.
+type responseCacheMarker struct {
+	immutable atomic.Bool
+}
+
+type responseCacheContextKey struct{}
+
+// MarkResponseImmutable marks the result of the current call as immutable,
+// allowing the server to cache the response. It has no effect if the responses
+// are not cached.
+func MarkResponseImmutable(ctx context.Context) {
+	if marker, ok := ctx.Value(responseCacheContextKey{}).(*responseCacheMarker); ok {
+		marker.immutable.Store(true)
+	}
+}
+
+// ResponseCacheEnabled reports whether the response of the current call may be
+// cached. Methods use this to skip checking whether their result is immutable.
+func ResponseCacheEnabled(ctx context.Context) bool {
+	_, ok := ctx.Value(responseCacheContextKey{}).(*responseCacheMarker)
+	return ok
+}
+
+// call runs the method through the cache. The result is served from the cache
+// if present, otherwise the method is run and its result stored if marked as
+// immutable.
+func (c *ResponseCache) call(ctx context.Context, msg *jsonrpcMessage, args []reflect.Value, run func(context.Context) *jsonrpcMessage) *jsonrpcMessage {
+	// Results computed while purging are stored in the dropped cache.
+	cache := c.cache.Load()
+
+	// The key is computed before running the method, as it may modify the
+	// arguments. Only the methods known to return immutable results are looked
+	// up in the cache.
+	key := responseCacheKey(msg.Method, args)
+	if _, ok := c.methods.Load(msg.Method); ok && key != "" {
+		if result, ok := cache.Get(key); ok {
+			rpcResponseCacheHitMeter.Mark(1)
+			return &jsonrpcMessage{Version: vsn, ID: msg.ID, Result: result}
+		}
+		rpcResponseCacheMissMeter.Mark(1)
+	}
+	marker := new(responseCacheMarker)
+	answer := run(context.WithValue(ctx, responseCacheContextKey{}, marker))
+	if answer.Error != nil || !marker.immutable.Load() {
+		return answer
+	}
+	c.methods.Store(msg.Method, struct{}{})
+	if key != "" {
+		cache.Add(key, answer.Result)
+	}
+	return answer
+}
+
+// responseCacheKey returns the cache key of the call, empty if the arguments
+// cannot be encoded. The arguments are encoded after decoding, making the key
+// independent of the formatting of the parameters.
+func responseCacheKey(method string, args []reflect.Value) string {
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		values[i] = arg.Interface()
+	}
+	enc, err := json.Marshal(values)
+	if err != nil {
+		return ""
+	}
+	return method + string(enc)
+}
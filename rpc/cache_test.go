@@ -0,0 +1,88 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+)
+
+// cacheTestService returns immutable results for the numbers below 10.
+type cacheTestService struct {
+	calls atomic.Int64
+}
+
+func (s *cacheTestService) Square(ctx context.Context, n int) int {
+	s.calls.Add(1)
+	if n < 10 {
+		MarkResponseImmutable(ctx)
+	}
+	return n * n
+}
+
+func (s *cacheTestService) Enabled(ctx context.Context) bool {
+	return ResponseCacheEnabled(ctx)
+}
+
+func TestResponseCache(t *testing.T) {
+	var (
+		service = new(cacheTestService)
+		cache   = NewResponseCache(1024 * 1024)
+		server  = newTestServer()
+	)
+	if err := server.RegisterName("cache", service); err != nil {
+		t.Fatal(err)
+	}
+	server.SetResponseCache(cache)
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	square := func(n int, calls int64) {
+		t.Helper()
+		var result int
+		if err := client.Call(&result, "cache_square", n); err != nil {
+			t.Fatalf("call failed: %v", err)
+		}
+		if result != n*n {
+			t.Fatalf("wrong result %d for %d", result, n)
+		}
+		if have := service.calls.Load(); have != calls {
+			t.Fatalf("wrong number of executions: have %d, want %d", have, calls)
+		}
+	}
+	// The first immutable result of the method should be cached as well.
+	square(2, 1)
+	square(2, 1)
+	square(3, 2)
+	square(3, 2)
+
+	// Mutable results should never be cached.
+	square(20, 3)
+	square(20, 4)
+
+	// Purging should drop the cached results.
+	cache.Purge()
+	square(2, 5)
+	square(2, 5)
+
+	var enabled bool
+	if err := client.Call(&enabled, "cache_enabled"); err != nil || !enabled {
+		t.Fatalf("expected response cache to be enabled, got %v (err %v)", enabled, err)
+	}
+}
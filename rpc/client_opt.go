@@ -41,6 +41,9 @@ type clientConfig struct {
 	idgen              func() ID
 	batchItemLimit     int
 	batchResponseLimit int
+	rateLimiter        *RateLimiter
+	responseCache      *ResponseCache
+	fallback           Fallback
 }
 
 func (cfg *clientConfig) initHeaders() {
@@ -65,6 +65,9 @@ type handler struct {
 	allowSubscribe       bool
 	batchRequestLimit    int
 	batchResponseMaxSize int
+	rateLimiter          *RateLimiter   // nil if the calls are not limited
+	responseCache        *ResponseCache // nil if the responses are not cached
+	fallback             Fallback       // nil if the calls cannot be served elsewhere
 
 	subLock    sync.Mutex
 	serverSubs map[ID]*Subscription
@@ -494,6 +497,10 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 
 // handleCall processes method calls.
 func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage {
+	policy := PeerInfoFromContext(cp.ctx).policy
+	if policy != nil && policy.AllowMethod != nil && !msg.isUnsubscribe() && !policy.AllowMethod(msg.Method) {
+		return msg.errorResponse(&methodNotFoundError{method: msg.Method})
+	}
 	if msg.isSubscribe() {
 		return h.handleSubscribe(cp, msg)
 	}
@@ -510,13 +517,31 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 	if callb == nil {
 		return msg.errorResponse(&methodNotFoundError{method: msg.Method})
 	}
+	limiter := h.rateLimiter
+	if policy != nil && policy.RateLimiter != nil {
+		limiter = policy.RateLimiter
+	}
+	if limiter != nil && callb != h.unsubscribeCb {
+		release, err := limiter.acquire(cp.ctx, msg.Method)
+		if err != nil {
+			return msg.errorResponse(err)
+		}
+		defer release()
+	}
 
 	args, err := parsePositionalArguments(msg.Params, callb.argTypes)
 	if err != nil {
 		return msg.errorResponse(&invalidParamsError{err.Error()})
 	}
 	start := time.Now()
-	answer := h.runMethod(cp.ctx, msg, callb, args)
+	var answer *jsonrpcMessage
+	if h.responseCache != nil && callb != h.unsubscribeCb {
+		answer = h.responseCache.call(cp.ctx, msg, args, func(ctx context.Context) *jsonrpcMessage {
+			return h.runMethod(ctx, msg, callb, args)
+		})
+	} else {
+		answer = h.runMethod(cp.ctx, msg, callb, args)
+	}
 
 	// Collect the statistics for RPC calls if metrics is enabled.
 	// We only care about pure rpc call. Filter out subscription.
@@ -576,11 +601,25 @@ func (h *handler) handleSubscribe(cp *callProc, msg *jsonrpcMessage) *jsonrpcMes
 func (h *handler) runMethod(ctx context.Context, msg *jsonrpcMessage, callb *callback, args []reflect.Value) *jsonrpcMessage {
 	result, err := callb.call(ctx, msg.Method, args)
 	if err != nil {
+		if h.fallback != nil && errors.Is(err, ErrNoHistoricalFallback) {
+			return h.runFallback(ctx, msg)
+		}
 		return msg.errorResponse(err)
 	}
 	return msg.response(result)
 }
 
+// runFallback serves the call through the fallback. The history served by the
+// fallback doesn't change anymore, so its results are immutable.
+func (h *handler) runFallback(ctx context.Context, msg *jsonrpcMessage) *jsonrpcMessage {
+	result, err := h.fallback(ctx, msg.Method, msg.Params)
+	if err != nil {
+		return msg.errorResponse(err)
+	}
+	MarkResponseImmutable(ctx)
+	return &jsonrpcMessage{Version: vsn, ID: msg.ID, Result: result}
+}
+
 // unsubscribe is the callback function for all *_unsubscribe calls.
 func (h *handler) unsubscribe(ctx context.Context, id ID) (bool, error) {
 	h.subLock.Lock()
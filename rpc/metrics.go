@@ -27,6 +27,10 @@ var (
 	rpcRequestGauge        = metrics.NewRegisteredGauge("rpc/requests", nil)
 	successfulRequestGauge = metrics.NewRegisteredGauge("rpc/success", nil)
 	failedRequestGauge     = metrics.NewRegisteredGauge("rpc/failure", nil)
+	rpcRateLimitedMeter    = metrics.NewRegisteredMeter("rpc/ratelimited", nil)
+
+	rpcResponseCacheHitMeter  = metrics.NewRegisteredMeter("rpc/cache/hit", nil)
+	rpcResponseCacheMissMeter = metrics.NewRegisteredMeter("rpc/cache/miss", nil)
 
 	// serveTimeHistName is the prefix of the per-request serving time histograms.
 	serveTimeHistName = "rpc/duration"
@@ -0,0 +1,284 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"golang.org/x/time/rate"
+)
+
+// maxRateLimitedClients is the number of clients tracked per method, the least
+// recently seen clients start over with a full bucket.
+const maxRateLimitedClients = 10000
+
+// MethodLimit holds the limits applied to the calls of an RPC method.
+type MethodLimit struct {
+	Rate          float64       `json:"rate,omitempty" toml:",omitempty"`          // Calls per second across all clients, 0 for unlimited
+	Burst         int           `json:"burst,omitempty" toml:",omitempty"`         // Calls allowed in a burst across all clients, defaults to the rate
+	ClientRate    float64       `json:"clientRate,omitempty" toml:",omitempty"`    // Calls per second of a single client, 0 for unlimited
+	ClientBurst   int           `json:"clientBurst,omitempty" toml:",omitempty"`   // Calls allowed in a burst of a single client, defaults to the client rate
+	MaxConcurrent int           `json:"maxConcurrent,omitempty" toml:",omitempty"` // Maximum number of concurrent executions, 0 for unlimited
+	QueueTimeout  time.Duration `json:"queueTimeout,omitempty" toml:",omitempty"`  // How long calls are queued when over the limits, 0 to reject right away
+}
+
+// RateLimits holds the limits of the RPC methods, keyed by the method name.
+// Namespace wildcards such as "debug_*" and the catch-all "*" are accepted as
+// well, the most specific key applies. The limits apply to every method
+// separately, also when configured through a wildcard.
+type RateLimits map[string]MethodLimit
+
+// lookup returns the limits applying to the given method.
+func (limits RateLimits) lookup(method string) (MethodLimit, bool) {
+	if limit, ok := limits[method]; ok {
+		return limit, true
+	}
+	if i := strings.Index(method, serviceMethodSeparator); i >= 0 {
+		if limit, ok := limits[method[:i+1]+"*"]; ok {
+			return limit, true
+		}
+	}
+	limit, ok := limits["*"]
+	return limit, ok
+}
+
+// validate checks the limits for invalid values.
+func (limits RateLimits) validate() error {
+	for method, limit := range limits {
+		if limit.Rate < 0 || limit.Burst < 0 || limit.ClientRate < 0 || limit.ClientBurst < 0 || limit.MaxConcurrent < 0 || limit.QueueTimeout < 0 {
+			return fmt.Errorf("invalid rate limit of %s: negative value", method)
+		}
+	}
+	return nil
+}
+
+// RateLimiter limits the rate and the concurrency of the RPC method calls. A
+// single limiter may be shared across multiple servers, so the limits apply
+// to all of them together.
+type RateLimiter struct {
+	set atomic.Pointer[rateLimiterSet]
+}
+
+// rateLimiterSet holds the limiters built from a specific configuration.
+type rateLimiterSet struct {
+	limits RateLimits
+
+	lock    sync.Mutex
+	methods map[string]*methodLimiter
+}
+
+// methodLimiter tracks the limits of a single method.
+type methodLimiter struct {
+	limit   MethodLimit
+	global  *rate.Limiter // nil if the rate is unlimited
+	slots   chan struct{} // nil if the concurrency is unlimited
+	clients lru.BasicLRU[string, *rate.Limiter]
+	lock    sync.Mutex // Protects the clients
+}
+
+// NewRateLimiter creates a limiter enforcing the given limits.
+func NewRateLimiter(limits RateLimits) (*RateLimiter, error) {
+	l := new(RateLimiter)
+	if err := l.SetLimits(limits); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// SetLimits replaces the limits. The calls being executed are accounted to the
+// previous limits, the new ones start over with full buckets.
+func (l *RateLimiter) SetLimits(limits RateLimits) error {
+	if err := limits.validate(); err != nil {
+		return err
+	}
+	l.set.Store(&rateLimiterSet{
+		limits:  maps.Clone(limits),
+		methods: make(map[string]*methodLimiter),
+	})
+	return nil
+}
+
+// Limits returns the limits currently enforced.
+func (l *RateLimiter) Limits() RateLimits {
+	return maps.Clone(l.set.Load().limits)
+}
+
+// acquire waits until the call of the given method is allowed by the limits,
+// returning the function to be called once the execution is done. An error is
+// returned if the call is over the limits and could not be queued.
+func (l *RateLimiter) acquire(ctx context.Context, method string) (func(), error) {
+	ml := l.set.Load().limiter(method)
+	if ml == nil {
+		return func() {}, nil
+	}
+	if ml.limit.QueueTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ml.limit.QueueTimeout)
+		defer cancel()
+	}
+	if err := ml.reserve(ctx, method, ml.client(rateLimitClientKey(ctx))); err != nil {
+		rpcRateLimitedMeter.Mark(1)
+		return nil, err
+	}
+	if ml.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case ml.slots <- struct{}{}:
+	default:
+		if ml.limit.QueueTimeout == 0 {
+			rpcRateLimitedMeter.Mark(1)
+			return nil, &limitExceededError{fmt.Sprintf("too many concurrent %s calls", method)}
+		}
+		select {
+		case ml.slots <- struct{}{}:
+		case <-ctx.Done():
+			rpcRateLimitedMeter.Mark(1)
+			return nil, &limitExceededError{fmt.Sprintf("too many concurrent %s calls", method)}
+		}
+	}
+	return func() { <-ml.slots }, nil
+}
+
+// limiter returns the limiter of the given method, creating it on first use.
+// Nil is returned if the method is not limited.
+func (s *rateLimiterSet) limiter(method string) *methodLimiter {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if ml, ok := s.methods[method]; ok {
+		return ml
+	}
+	limit, ok := s.limits.lookup(method)
+	if !ok || (limit.Rate == 0 && limit.ClientRate == 0 && limit.MaxConcurrent == 0) {
+		s.methods[method] = nil
+		return nil
+	}
+	ml := &methodLimiter{
+		limit:   limit,
+		clients: lru.NewBasicLRU[string, *rate.Limiter](maxRateLimitedClients),
+	}
+	if limit.Rate > 0 {
+		ml.global = newTokenBucket(limit.Rate, limit.Burst)
+	}
+	if limit.MaxConcurrent > 0 {
+		ml.slots = make(chan struct{}, limit.MaxConcurrent)
+	}
+	s.methods[method] = ml
+	return ml
+}
+
+// client returns the token bucket of the given client, nil if the rate of the
+// clients is unlimited.
+func (ml *methodLimiter) client(key string) *rate.Limiter {
+	if ml.limit.ClientRate == 0 {
+		return nil
+	}
+	ml.lock.Lock()
+	defer ml.lock.Unlock()
+
+	bucket, ok := ml.clients.Get(key)
+	if !ok {
+		bucket = newTokenBucket(ml.limit.ClientRate, ml.limit.ClientBurst)
+		ml.clients.Add(key, bucket)
+	}
+	return bucket
+}
+
+// reserve takes a token from the bucket of the client and the global bucket
+// of the method, waiting for them if queueing is enabled. The tokens are only
+// taken if both buckets allow the call, the rejected calls don't drain the
+// bucket of the other limit.
+func (ml *methodLimiter) reserve(ctx context.Context, method string, client *rate.Limiter) error {
+	var (
+		now          = time.Now()
+		reservations []*rate.Reservation
+		delay        time.Duration
+		exceeded     string
+	)
+	take := func(bucket *rate.Limiter, msg string) {
+		if bucket == nil {
+			return
+		}
+		r := bucket.ReserveN(now, 1)
+		reservations = append(reservations, r)
+		if d := r.DelayFrom(now); d > delay {
+			delay, exceeded = d, msg
+		}
+	}
+	take(client, fmt.Sprintf("rate limit of %s exceeded for client", method))
+	take(ml.global, fmt.Sprintf("rate limit of %s exceeded", method))
+	if delay == 0 {
+		return nil
+	}
+	// Tokens reserved for the future are given back on cancellation, as well
+	// as the ones available right away as long as the time is unchanged.
+	cancel := func(at time.Time) {
+		for _, r := range reservations {
+			r.CancelAt(at)
+		}
+	}
+	deadline, ok := ctx.Deadline()
+	if ml.limit.QueueTimeout == 0 || (ok && deadline.Sub(now) < delay) {
+		cancel(now)
+		return &limitExceededError{exceeded}
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		cancel(time.Now())
+		return &limitExceededError{exceeded}
+	}
+}
+
+// newTokenBucket creates a token bucket, defaulting the burst to the rate.
+func newTokenBucket(r float64, burst int) *rate.Limiter {
+	if burst == 0 {
+		burst = int(math.Max(1, math.Ceil(r)))
+	}
+	return rate.NewLimiter(rate.Limit(r), burst)
+}
+
+// rateLimitClientKey identifies the client of the call for the rate limits. The
+// authenticated clients are identified by their identity, the others by their
+// IP address.
+func rateLimitClientKey(ctx context.Context) string {
+	info := PeerInfoFromContext(ctx)
+	if info.ClientID != "" {
+		return "id:" + info.ClientID
+	}
+	if host, _, err := net.SplitHostPort(info.RemoteAddr); err == nil {
+		return "ip:" + host
+	}
+	if info.RemoteAddr != "" {
+		return "ip:" + info.RemoteAddr
+	}
+	return info.Transport
+}
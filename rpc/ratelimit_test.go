@@ -0,0 +1,171 @@
+// Copyright 2026 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimitsLookup(t *testing.T) {
+	limits := RateLimits{
+		"test_echo": {Rate: 1},
+		"test_*":    {Rate: 2},
+		"*":         {Rate: 3},
+	}
+	tests := map[string]float64{
+		"test_echo":  1,
+		"test_sleep": 2,
+		"eth_call":   3,
+	}
+	for method, want := range tests {
+		if limit, ok := limits.lookup(method); !ok || limit.Rate != want {
+			t.Errorf("%s: wrong limit %v, want rate %v", method, limit, want)
+		}
+	}
+	if _, ok := (RateLimits{"test_*": {Rate: 1}}).lookup("eth_call"); ok {
+		t.Error("unexpected limit of unlisted method")
+	}
+	if _, err := NewRateLimiter(RateLimits{"test_echo": {Rate: -1}}); err == nil {
+		t.Error("expected error for negative rate")
+	}
+}
+
+func TestRateLimiterReject(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimits{"test_echo": {Rate: 0.001, Burst: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := newTestServer()
+	server.SetRateLimiter(limiter)
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	var resp echoResult
+	for i := 0; i < 2; i++ {
+		if err := client.Call(&resp, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+			t.Fatalf("call %d failed: %v", i, err)
+		}
+	}
+	err = client.Call(&resp, "test_echo", "hello", 10, &echoArgs{"world"})
+	var rpcErr Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != errcodeLimitExceeded {
+		t.Fatalf("expected limit exceeded error, got %v", err)
+	}
+	// Other methods should not be affected.
+	if err := client.Call(nil, "test_noArgsRets"); err != nil {
+		t.Fatalf("unlimited call failed: %v", err)
+	}
+	// Reloading the limits should start over with full buckets.
+	if err := limiter.SetLimits(RateLimits{"test_echo": {Rate: 0.001, Burst: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Call(&resp, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatalf("call after reload failed: %v", err)
+	}
+	if limiter.Limits()["test_echo"].Burst != 1 {
+		t.Fatalf("wrong limits after reload: %v", limiter.Limits())
+	}
+}
+
+func TestRateLimiterClients(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimits{"*": {ClientRate: 0.001, ClientBurst: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		alice = context.WithValue(context.Background(), peerInfoContextKey{}, PeerInfo{ClientID: "alice"})
+		bob   = context.WithValue(context.Background(), peerInfoContextKey{}, PeerInfo{ClientID: "bob"})
+	)
+	for _, ctx := range []context.Context{alice, bob} {
+		release, err := limiter.acquire(ctx, "test_echo")
+		if err != nil {
+			t.Fatalf("first call failed: %v", err)
+		}
+		release()
+	}
+	if _, err := limiter.acquire(alice, "test_echo"); err == nil {
+		t.Fatal("expected second call of the same client to be limited")
+	}
+}
+
+func TestRateLimiterRejectKeepsTokens(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimits{"test_echo": {Rate: 0.001, Burst: 2, ClientRate: 0.001, ClientBurst: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		alice = context.WithValue(context.Background(), peerInfoContextKey{}, PeerInfo{ClientID: "alice"})
+		bob   = context.WithValue(context.Background(), peerInfoContextKey{}, PeerInfo{ClientID: "bob"})
+		carol = context.WithValue(context.Background(), peerInfoContextKey{}, PeerInfo{ClientID: "carol"})
+		ml    = limiter.set.Load().limiter("test_echo")
+	)
+	if _, err := limiter.acquire(alice, "test_echo"); err != nil {
+		t.Fatalf("first call failed: %v", err)
+	}
+	// Calls rejected by the client limit should not drain the global bucket.
+	if _, err := limiter.acquire(alice, "test_echo"); err == nil {
+		t.Fatal("expected second call of the same client to be limited")
+	}
+	if _, err := limiter.acquire(bob, "test_echo"); err != nil {
+		t.Fatalf("call of another client failed: %v", err)
+	}
+	// Calls rejected by the global limit should not drain the client bucket.
+	if _, err := limiter.acquire(carol, "test_echo"); err == nil {
+		t.Fatal("expected call over the global limit to be rejected")
+	}
+	if tokens := ml.client("id:carol").Tokens(); tokens < 1 {
+		t.Fatalf("client token taken by rejected call, %v tokens left", tokens)
+	}
+}
+
+func TestRateLimiterConcurrency(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimits{"test_sleep": {MaxConcurrent: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	release, err := limiter.acquire(context.Background(), "test_sleep")
+	if err != nil {
+		t.Fatalf("first call failed: %v", err)
+	}
+	if _, err := limiter.acquire(context.Background(), "test_sleep"); err == nil {
+		t.Fatal("expected concurrent call to be rejected")
+	}
+	release()
+
+	// With queueing enabled, the call should wait for the slot to be released.
+	limiter.SetLimits(RateLimits{"test_sleep": {MaxConcurrent: 1, QueueTimeout: time.Second}})
+	release, err = limiter.acquire(context.Background(), "test_sleep")
+	if err != nil {
+		t.Fatalf("first call failed: %v", err)
+	}
+	time.AfterFunc(50*time.Millisecond, release)
+	if release, err = limiter.acquire(context.Background(), "test_sleep"); err != nil {
+		t.Fatalf("queued call failed: %v", err)
+	}
+	// Queued calls should give up once the timeout expires.
+	limiter.SetLimits(RateLimits{"test_sleep": {MaxConcurrent: 1, QueueTimeout: 50 * time.Millisecond}})
+	if _, err = limiter.acquire(context.Background(), "test_sleep"); err != nil {
+		t.Fatalf("first call failed: %v", err)
+	}
+	if _, err := limiter.acquire(context.Background(), "test_sleep"); err == nil {
+		t.Fatal("expected queued call to time out")
+	}
+}
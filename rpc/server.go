@@ -18,6 +18,7 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"net"
@@ -55,6 +56,9 @@ type Server struct {
 	batchResponseLimit int
 	httpBodyLimit      int
 	wsReadLimit        int64
+	rateLimiter        *RateLimiter
+	responseCache      *ResponseCache
+	fallback           Fallback
 }
 
 // NewServer creates a new server instance with no registered handlers.
@@ -98,6 +102,39 @@ func (s *Server) SetWebsocketReadLimit(limit int64) {
 	s.wsReadLimit = limit
 }
 
+// SetRateLimiter sets the limiter applied to the method calls. The limiter may be
+// shared by multiple servers.
+//
+// This method should be called before processing any requests via ServeCodec, ServeHTTP,
+// ServeListener etc.
+func (s *Server) SetRateLimiter(limiter *RateLimiter) {
+	s.rateLimiter = limiter
+}
+
+// SetResponseCache sets the cache of the immutable responses. The cache may be
+// shared by multiple servers.
+//
+// This method should be called before processing any requests via ServeCodec, ServeHTTP,
+// ServeListener etc.
+func (s *Server) SetResponseCache(cache *ResponseCache) {
+	s.responseCache = cache
+}
+
+// Fallback serves the calls which the node cannot serve itself, i.e. the calls
+// whose method failed with ErrNoHistoricalFallback because they depend on the
+// state of pre-Bedrock blocks. It is given the method and the parameters of the
+// call, and returns the JSON encoded result.
+type Fallback func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error)
+
+// SetFallback sets the fallback serving the calls which depend on data the node
+// doesn't have. Without a fallback, such calls fail with ErrNoHistoricalFallback.
+//
+// This method should be called before processing any requests via ServeCodec, ServeHTTP,
+// ServeListener etc.
+func (s *Server) SetFallback(fallback Fallback) {
+	s.fallback = fallback
+}
+
 // RegisterName creates a service for the given receiver type under the given name. When no
 // methods on the given receiver match the criteria to be either an RPC method or a
 // subscription an error is returned. Otherwise a new service is created and added to the
@@ -123,6 +160,9 @@ func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
 		idgen:              s.idgen,
 		batchItemLimit:     s.batchItemLimit,
 		batchResponseLimit: s.batchResponseLimit,
+		rateLimiter:        s.rateLimiter,
+		responseCache:      s.responseCache,
+		fallback:           s.fallback,
 	}
 	c := initClient(codec, &s.services, cfg)
 	<-codec.closed()
@@ -158,6 +198,9 @@ func (s *Server) serveSingleRequest(ctx context.Context, codec ServerCodec) {
 
 	h := newHandler(ctx, codec, s.idgen, &s.services, s.batchItemLimit, s.batchResponseLimit)
 	h.allowSubscribe = false
+	h.rateLimiter = s.rateLimiter
+	h.responseCache = s.responseCache
+	h.fallback = s.fallback
 	defer h.close(io.EOF, nil)
 
 	reqs, batch, err := codec.readBatch()
@@ -235,6 +278,13 @@ type PeerInfo struct {
 	// Address of client. This will usually contain the IP address and port.
 	RemoteAddr string
 
+	// Identity of the authenticated client, e.g. the name of its API key.
+	// This is empty for unauthenticated clients.
+	ClientID string
+
+	// Restrictions of the authenticated client, nil if unrestricted.
+	policy *ClientPolicy
+
 	// Additional information for HTTP and WebSocket connections.
 	HTTP struct {
 		// Protocol version, i.e. "HTTP/1.1". This is not set for WebSocket.
@@ -248,6 +298,48 @@ type PeerInfo struct {
 
 type peerInfoContextKey struct{}
 
+type clientIDContextKey struct{}
+
+// NewContextWithClientID returns a copy of ctx carrying the identity of the
+// authenticated client. HTTP middlewares use this to make the identity available
+// in the PeerInfo of the calls served from the request.
+func NewContextWithClientID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, clientIDContextKey{}, id)
+}
+
+// clientIDFromContext returns the identity of the authenticated client.
+func clientIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(clientIDContextKey{}).(string)
+	return id
+}
+
+// ClientPolicy restricts the calls of an authenticated client.
+type ClientPolicy struct {
+	// AllowMethod reports whether the client may call the given method. The
+	// calls of other methods fail as if the method did not exist. All methods
+	// are allowed if nil.
+	AllowMethod func(method string) bool
+
+	// RateLimiter enforces the limits of the client in place of the limits of
+	// the server, if set.
+	RateLimiter *RateLimiter
+}
+
+type clientPolicyContextKey struct{}
+
+// NewContextWithClientPolicy returns a copy of ctx carrying the restrictions of
+// the authenticated client. HTTP middlewares use this to restrict the calls
+// served from the request.
+func NewContextWithClientPolicy(ctx context.Context, policy *ClientPolicy) context.Context {
+	return context.WithValue(ctx, clientPolicyContextKey{}, policy)
+}
+
+// setClient fills in the authenticated client carried by the request context.
+func (info *PeerInfo) setClient(ctx context.Context) {
+	info.ClientID = clientIDFromContext(ctx)
+	info.policy, _ = ctx.Value(clientPolicyContextKey{}).(*ClientPolicy)
+}
+
 // PeerInfoFromContext returns information about the client's network connection.
 // Use this with the context passed to RPC method handler functions.
 //
@@ -18,6 +18,7 @@ package triedb
 
 import (
 	"errors"
+	"io"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/triedb/pathdb"
@@ -70,3 +71,33 @@ func (db *Database) HistoryRange() (uint64, uint64, error) {
 	}
 	return pdb.HistoryRange()
 }
+
+// ExportHistory writes the state histories (along with the trienode histories
+// if maintained) within the specified range into the writer.
+//
+// First: State ID of the first history object to export. 0 implies the first
+// available object is selected as the starting point.
+//
+// Last: State ID of the last history object to export. 0 implies the last
+// available object is selected as the ending point.
+//
+// This function is only supported by path mode database.
+func (db *Database) ExportHistory(w io.Writer, first, last uint64) error {
+	pdb, ok := db.backend.(*pathdb.Database)
+	if !ok {
+		return errors.New("not supported")
+	}
+	return pdb.ExportHistory(w, first, last)
+}
+
+// ImportHistory imports the histories exported by ExportHistory, extending
+// the local histories backwards.
+//
+// This function is only supported by path mode database.
+func (db *Database) ImportHistory(r io.ReaderAt) error {
+	pdb, ok := db.backend.(*pathdb.Database)
+	if !ok {
+		return errors.New("not supported")
+	}
+	return pdb.ImportHistory(r)
+}
@@ -70,6 +70,7 @@ var ReadOnly = &Config{
 type Config struct {
 	StateHistory        uint64 // Number of recent blocks to maintain state history for, 0: full chain
 	EnableStateIndexing bool   // Whether to enable state history indexing for external state access
+	TrienodeHistory     bool   // Whether to retain trie node histories for historical proof generation
 	TrieCleanSize       int    // Maximum memory allowance (in bytes) for caching clean trie data
 	StateCleanSize      int    // Maximum memory allowance (in bytes) for caching clean state data
 	WriteBufferSize     int    // Maximum memory allowance (in bytes) for write buffer
@@ -111,6 +112,9 @@ func (c *Config) fields() []interface{} {
 	if c.EnableStateIndexing {
 		list = append(list, "index-history", true)
 	}
+	if c.TrienodeHistory {
+		list = append(list, "trienode-history", true)
+	}
 	if c.JournalDirectory != "" {
 		list = append(list, "journal-dir", c.JournalDirectory)
 	}
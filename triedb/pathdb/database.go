@@ -134,8 +134,10 @@ type Database struct {
 	diskdb ethdb.Database // Persistent storage for matured trie nodes
 	tree   *layerTree     // The group for all known layers
 
-	stateFreezer ethdb.ResettableAncientStore // Freezer for storing state histories, nil possible in tests
-	stateIndexer *historyIndexer              // History indexer historical state data, nil possible
+	stateFreezer    ethdb.ResettableAncientStore // Freezer for storing state histories, nil possible in tests
+	stateIndexer    *historyIndexer              // History indexer historical state data, nil possible
+	trienodeFreezer ethdb.ResettableAncientStore // Freezer for storing trienode histories, nil possible
+	trienodeIndexer *historyIndexer              // History indexer for historical trie nodes, nil possible
 
 	lock sync.RWMutex // Lock to prevent mutations from happening at the same time
 }
@@ -192,6 +194,10 @@ func New(diskdb ethdb.Database, config *Config, isVerkle bool) *Database {
 		db.stateIndexer = newHistoryIndexer(db.diskdb, db.stateFreezer, db.tree.bottom().stateID(), typeStateHistory)
 		log.Info("Enabled state history indexing")
 	}
+	if db.trienodeFreezer != nil && db.config.EnableStateIndexing {
+		db.trienodeIndexer = newHistoryIndexer(db.diskdb, db.trienodeFreezer, db.tree.bottom().stateID(), typeTrienodeHistory)
+		log.Info("Enabled trienode history indexing")
+	}
 	fields := config.fields()
 	if db.isVerkle {
 		fields = append(fields, "verkle", true)
@@ -219,6 +225,16 @@ func (db *Database) repairHistory() error {
 	}
 	db.stateFreezer = freezer
 
+	// Open the freezer for trienode history if it's permitted. The trienode
+	// histories are stored alongside with the state histories and must be
+	// aligned with them.
+	if db.config.TrienodeHistory {
+		freezer, err := rawdb.NewTrienodeFreezer(ancient, db.isVerkle, db.readOnly)
+		if err != nil {
+			log.Crit("Failed to open trienode history freezer", "err", err)
+		}
+		db.trienodeFreezer = freezer
+	}
 	// Reset the entire state histories if the trie database is not initialized
 	// yet. This action is necessary because these state histories are not
 	// expected to exist without an initialized trie database.
@@ -241,7 +257,7 @@ func (db *Database) repairHistory() error {
 			}
 			log.Info("Truncated extraneous state history")
 		}
-		return nil
+		return db.repairTrienodeHistory(id)
 	}
 	// Truncate the extra state histories above in freezer in case it's not
 	// aligned with the disk layer. It might happen after a unclean shutdown.
@@ -252,6 +268,51 @@ func (db *Database) repairHistory() error {
 	if pruned != 0 {
 		log.Warn("Truncated extra state histories", "number", pruned)
 	}
+	return db.repairTrienodeHistory(id)
+}
+
+// repairTrienodeHistory aligns the trienode histories with the disk layer.
+// The trienode history can only be maintained if it's continuous with the
+// persistent state, it will be disabled otherwise (e.g. the feature is
+// enabled on a database with existing state histories).
+func (db *Database) repairTrienodeHistory(id uint64) error {
+	if db.trienodeFreezer == nil {
+		return nil
+	}
+	head, err := db.trienodeFreezer.Ancients()
+	if err != nil {
+		log.Crit("Failed to retrieve head of trienode history", "err", err)
+	}
+	if id == 0 {
+		if head != 0 {
+			batch := db.diskdb.NewBatch()
+			rawdb.DeleteTrienodeHistoryIndexMetadata(batch)
+			rawdb.DeleteTrienodeHistoryIndexes(batch)
+			if err := batch.Write(); err != nil {
+				log.Crit("Failed to purge trienode history index", "err", err)
+			}
+			if err := db.trienodeFreezer.Reset(); err != nil {
+				log.Crit("Failed to reset trienode histories", "err", err)
+			}
+			log.Info("Truncated extraneous trienode history")
+		}
+		return nil
+	}
+	if head < id {
+		log.Warn("Trienode history is not aligned with state, disabled", "head", head, "state", id)
+		if err := db.trienodeFreezer.Close(); err != nil {
+			return err
+		}
+		db.trienodeFreezer = nil
+		return nil
+	}
+	pruned, err := truncateFromHead(db.trienodeFreezer, typeTrienodeHistory, id)
+	if err != nil {
+		log.Crit("Failed to truncate extra trienode histories", "err", err)
+	}
+	if pruned != 0 {
+		log.Warn("Truncated extra trienode histories", "number", pruned)
+	}
 	return nil
 }
 
@@ -333,8 +394,13 @@ func (db *Database) Update(root common.Hash, parentRoot common.Hash, block uint6
 	if err := db.modifyAllowed(); err != nil {
 		return err
 	}
-	// TODO(rjl493456442) tracking the origins in the following PRs.
-	if err := db.tree.add(root, parentRoot, block, NewNodeSetWithOrigin(nodes.Nodes(), nil), states); err != nil {
+	// The original values of trie nodes are only tracked if the trienode
+	// history is maintained, saving the memory otherwise.
+	set := NewNodeSetWithOrigin(nodes.Nodes(), nil)
+	if db.trienodeFreezer != nil {
+		set = NewNodeSetWithOrigin(nodes.NodeAndOrigins())
+	}
+	if err := db.tree.add(root, parentRoot, block, set, states); err != nil {
 		return err
 	}
 	// Keep 128 diff layers in the memory, persistent layer is 129th.
@@ -434,6 +500,17 @@ func (db *Database) Enable(root common.Hash) error {
 			return err
 		}
 	}
+	if db.trienodeFreezer != nil {
+		batch.Reset()
+		rawdb.DeleteTrienodeHistoryIndexMetadata(batch)
+		rawdb.DeleteTrienodeHistoryIndexes(batch)
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		if err := db.trienodeFreezer.Reset(); err != nil {
+			return err
+		}
+	}
 	// Re-enable the database as the final step.
 	db.waitSync = false
 	rawdb.WriteSnapSyncStatusFlag(db.diskdb, rawdb.StateSyncFinished)
@@ -451,6 +528,11 @@ func (db *Database) Enable(root common.Hash) error {
 		db.stateIndexer = newHistoryIndexer(db.diskdb, db.stateFreezer, db.tree.bottom().stateID(), typeStateHistory)
 		log.Info("Re-enabled state history indexing")
 	}
+	if db.trienodeIndexer != nil && db.trienodeFreezer != nil && db.config.EnableStateIndexing {
+		db.trienodeIndexer.close()
+		db.trienodeIndexer = newHistoryIndexer(db.diskdb, db.trienodeFreezer, db.tree.bottom().stateID(), typeTrienodeHistory)
+		log.Info("Re-enabled trienode history indexing")
+	}
 	log.Info("Rebuilt trie database", "root", root)
 	return nil
 }
@@ -506,6 +588,11 @@ func (db *Database) Recover(root common.Hash) error {
 	if err != nil {
 		return err
 	}
+	if db.trienodeFreezer != nil {
+		if _, err := truncateFromHead(db.trienodeFreezer, typeTrienodeHistory, dl.stateID()); err != nil {
+			return err
+		}
+	}
 	log.Debug("Recovered state", "root", root, "elapsed", common.PrettyDuration(time.Since(start)))
 	return nil
 }
@@ -566,7 +653,15 @@ func (db *Database) Close() error {
 	if db.stateIndexer != nil {
 		db.stateIndexer.close()
 	}
-	// Close the attached state history freezer.
+	if db.trienodeIndexer != nil {
+		db.trienodeIndexer.close()
+	}
+	// Close the attached trienode and state history freezers.
+	if db.trienodeFreezer != nil {
+		if err := db.trienodeFreezer.Close(); err != nil {
+			return err
+		}
+	}
 	if db.stateFreezer == nil {
 		return nil
 	}
@@ -644,12 +739,25 @@ func (db *Database) HistoryRange() (uint64, uint64, error) {
 }
 
 // IndexProgress returns the indexing progress made so far. It provides the
-// number of states that remain unindexed.
+// number of states that remain unindexed, taking the trienode histories into
+// account if they are maintained.
 func (db *Database) IndexProgress() (uint64, error) {
-	if db.stateIndexer == nil {
-		return 0, nil
+	var remain uint64
+	if db.stateIndexer != nil {
+		n, err := db.stateIndexer.progress()
+		if err != nil {
+			return 0, err
+		}
+		remain = n
+	}
+	if db.trienodeIndexer != nil {
+		n, err := db.trienodeIndexer.progress()
+		if err != nil {
+			return 0, err
+		}
+		remain = max(remain, n)
 	}
-	return db.stateIndexer.progress()
+	return remain, nil
 }
 
 // AccountIterator creates a new account iterator for the specified root hash and
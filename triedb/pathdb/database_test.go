@@ -142,6 +142,7 @@ type testerConfig struct {
 	stateHistory uint64 // Number of historical states to retain
 	layers       int    // Number of state transitions to generate for
 	enableIndex  bool   // Enable state history indexing or not
+	trienodes    bool   // Enable trienode history or not
 	journalDir   string // Directory path for persisting journal files
 	isVerkle     bool   // Enables Verkle trie mode if true
 
@@ -177,6 +178,7 @@ func newTester(t *testing.T, config *testerConfig) *tester {
 		db      = New(disk, &Config{
 			StateHistory:        config.stateHistory,
 			EnableStateIndexing: config.enableIndex,
+			TrienodeHistory:     config.trienodes,
 			TrieCleanSize:       config.trieCacheSize(),
 			StateCleanSize:      config.stateCacheSize(),
 			WriteBufferSize:     config.writeBufferSize(),
@@ -346,6 +346,18 @@ func (dl *diskLayer) writeStateHistory(diff *diffLayer) (bool, error) {
 			return false, err
 		}
 	}
+	// Store the trienode history as well if it's permitted, the two histories
+	// are always aligned with each other.
+	if dl.db.trienodeFreezer != nil {
+		if err := writeTrienodeHistory(dl.db.trienodeFreezer, diff); err != nil {
+			return false, err
+		}
+		if dl.db.trienodeIndexer != nil {
+			if err := dl.db.trienodeIndexer.extend(diff.stateID()); err != nil {
+				return false, err
+			}
+		}
+	}
 	// Determine if the persisted history object has exceeded the
 	// configured limitation.
 	limit := dl.db.config.StateHistory
@@ -382,6 +394,11 @@ func (dl *diskLayer) writeStateHistory(diff *diffLayer) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if dl.db.trienodeFreezer != nil {
+		if _, err := truncateFromTail(dl.db.trienodeFreezer, typeTrienodeHistory, newFirst-1); err != nil {
+			return false, err
+		}
+	}
 	log.Debug("Pruned state history", "items", pruned, "tailid", newFirst)
 	return false, nil
 }
@@ -510,6 +527,11 @@ func (dl *diskLayer) revert(h *stateHistory) (*diskLayer, error) {
 			return nil, err
 		}
 	}
+	if dl.db.trienodeIndexer != nil {
+		if err := dl.db.trienodeIndexer.shorten(dl.id); err != nil {
+			return nil, err
+		}
+	}
 	// State change may be applied to node buffer, or the persistent
 	// state, depends on if node buffer is empty or not. If the node
 	// buffer is not empty, it means that the state transition that
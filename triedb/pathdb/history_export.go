@@ -0,0 +1,655 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pathdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/internal/era/e2store"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// The history export file is a self-describing container of a contiguous
+// range of histories, encoded in the e2store format:
+//
+//	history-file   := Version | Header | entry* | Checksum
+//	entry          := state-entry | state-entry trienode-entry
+//	state-entry    := StateMeta | AccountIndex | StorageIndex | AccountData | StorageData
+//	trienode-entry := TrienodeHeader | TrienodeKeys | TrienodeValues
+//
+//	Version  = { type: 0x3265, data: nil }
+//	Header   = { type: 0x4801, data: rlp([version, first, last, trienode]) }
+//	Checksum = { type: 0x48ff, data: keccak256(all preceding bytes) }
+//
+// Each entry carries the raw freezer items of a single history, the entries
+// are sorted by history id in ascending order. The trienode entries are only
+// present if the trienode flag is set in the header.
+const (
+	historyFileVersion = 0 // the version of the history export file
+
+	historyTypeVersion  uint16 = 0x3265
+	historyTypeHeader   uint16 = 0x4801
+	historyTypeState    uint16 = 0x4810 // the first of the five state history records
+	historyTypeTrienode uint16 = 0x4820 // the first of the three trienode history records
+	historyTypeChecksum uint16 = 0x48ff
+
+	stateHistoryItems    = 5 // the number of freezer items of a state history
+	trienodeHistoryItems = 3 // the number of freezer items of a trienode history
+)
+
+// historyFileHeader describes the content of a history export file.
+type historyFileHeader struct {
+	Version  uint64
+	First    uint64 // The id of the first history in the file
+	Last     uint64 // The id of the last history in the file (included)
+	Trienode bool   // Whether the trienode histories are included
+}
+
+// readHistoryItems reads the raw freezer items of the history with the given id.
+func readHistoryItems(reader ethdb.AncientReaderOp, typ historyType, id uint64) ([][]byte, error) {
+	if typ == typeStateHistory {
+		m, accountIndex, storageIndex, accountData, storageData, err := rawdb.ReadStateHistory(reader, id)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{m, accountIndex, storageIndex, accountData, storageData}, nil
+	}
+	header, keySection, valueSection, err := rawdb.ReadTrienodeHistory(reader, id)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{header, keySection, valueSection}, nil
+}
+
+// writeHistoryItems writes the raw freezer items of the history with the given id.
+func writeHistoryItems(writer ethdb.AncientWriter, typ historyType, id uint64, items [][]byte) error {
+	if typ == typeStateHistory {
+		return rawdb.WriteStateHistory(writer, id, items[0], items[1], items[2], items[3], items[4])
+	}
+	return rawdb.WriteTrienodeHistory(writer, id, items[0], items[1], items[2])
+}
+
+// copyHistories copies the histories within the range [start, end] from the
+// source freezer to the destination freezer.
+func copyHistories(src ethdb.AncientReaderOp, dst ethdb.AncientWriter, typ historyType, start, end uint64) error {
+	for id := start; id <= end; id++ {
+		items, err := readHistoryItems(src, typ, id)
+		if err != nil {
+			return err
+		}
+		if err := writeHistoryItems(dst, typ, id, items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportHistories writes the histories within the range [first, last] into
+// the writer in the history file format. The trienode histories are included
+// if the trienode freezer is not nil.
+func exportHistories(w io.Writer, states ethdb.AncientReaderOp, trienodes ethdb.AncientReaderOp, first, last uint64) error {
+	var (
+		hasher = crypto.NewKeccakState()
+		writer = e2store.NewWriter(io.MultiWriter(w, hasher))
+	)
+	header, err := rlp.EncodeToBytes(&historyFileHeader{
+		Version:  historyFileVersion,
+		First:    first,
+		Last:     last,
+		Trienode: trienodes != nil,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(historyTypeVersion, nil); err != nil {
+		return err
+	}
+	if _, err := writer.Write(historyTypeHeader, header); err != nil {
+		return err
+	}
+	var (
+		start  = time.Now()
+		logged = time.Now()
+	)
+	for id := first; id <= last; id++ {
+		items, err := readHistoryItems(states, typeStateHistory, id)
+		if err != nil {
+			return err
+		}
+		for i, item := range items {
+			if _, err := writer.Write(historyTypeState+uint16(i), item); err != nil {
+				return err
+			}
+		}
+		if trienodes != nil {
+			items, err := readHistoryItems(trienodes, typeTrienodeHistory, id)
+			if err != nil {
+				return err
+			}
+			for i, item := range items {
+				if _, err := writer.Write(historyTypeTrienode+uint16(i), item); err != nil {
+					return err
+				}
+			}
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Exporting histories", "id", id, "last", last, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	var checksum common.Hash
+	hasher.Read(checksum[:])
+	_, err = e2store.NewWriter(w).Write(historyTypeChecksum, checksum.Bytes())
+	return err
+}
+
+// historyFile is a verified history export file.
+type historyFile struct {
+	reader *e2store.Reader
+	header historyFileHeader
+	body   int64         // The offset of the first history entry
+	roots  []common.Hash // The state roots, roots[i] belongs to the state with id first-1+i
+}
+
+// openHistoryFile opens the history export file and verifies its integrity,
+// including the checksum and the continuity of the contained histories.
+func openHistoryFile(r io.ReaderAt) (*historyFile, error) {
+	var (
+		reader = e2store.NewReader(r)
+		entry  e2store.Entry
+		offset int64
+	)
+	n, err := reader.ReadAt(&entry, offset)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Type != historyTypeVersion {
+		return nil, fmt.Errorf("invalid version record, type: %#x", entry.Type)
+	}
+	offset += int64(n)
+
+	n, err = reader.ReadAt(&entry, offset)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Type != historyTypeHeader {
+		return nil, fmt.Errorf("invalid header record, type: %#x", entry.Type)
+	}
+	offset += int64(n)
+
+	var header historyFileHeader
+	if err := rlp.DecodeBytes(entry.Value, &header); err != nil {
+		return nil, err
+	}
+	if header.Version != historyFileVersion {
+		return nil, fmt.Errorf("unsupported history file version %d", header.Version)
+	}
+	if header.First == 0 || header.First > header.Last {
+		return nil, fmt.Errorf("invalid history range [%d, %d]", header.First, header.Last)
+	}
+	file := &historyFile{
+		reader: reader,
+		header: header,
+		body:   offset,
+	}
+	// Locate the checksum record by skipping all the history entries
+	records := (header.Last - header.First + 1) * stateHistoryItems
+	if header.Trienode {
+		records += (header.Last - header.First + 1) * trienodeHistoryItems
+	}
+	offset, err = reader.SkipN(offset, records)
+	if err != nil {
+		return nil, err
+	}
+	n, err = reader.ReadAt(&entry, offset)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Type != historyTypeChecksum {
+		return nil, fmt.Errorf("invalid checksum record, type: %#x", entry.Type)
+	}
+	hasher := crypto.NewKeccakState()
+	if _, err := io.Copy(hasher, io.NewSectionReader(r, 0, offset)); err != nil {
+		return nil, err
+	}
+	var checksum common.Hash
+	hasher.Read(checksum[:])
+	if !bytes.Equal(checksum.Bytes(), entry.Value) {
+		return nil, fmt.Errorf("checksum mismatch, want: %x, got: %x", entry.Value, checksum)
+	}
+	// Ensure the contained histories are linked with each other
+	err = file.iterate(header.First, header.Last, func(id uint64, states [][]byte, trienodes [][]byte) error {
+		var m meta
+		if err := m.decode(states[0]); err != nil {
+			return err
+		}
+		if len(file.roots) == 0 {
+			file.roots = append(file.roots, m.parent)
+		}
+		if parent := file.roots[len(file.roots)-1]; m.parent != parent {
+			return fmt.Errorf("state history %d is not contiguous, want parent: %x, got: %x", id, parent, m.parent)
+		}
+		if trienodes != nil {
+			tm, _, _, _, err := decodeHeader(trienodes[0])
+			if err != nil {
+				return err
+			}
+			if tm.root != m.root || tm.parent != m.parent {
+				return fmt.Errorf("trienode history %d is not aligned with state history", id)
+			}
+		}
+		file.roots = append(file.roots, m.root)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// root returns the state root of the state with the given id, which must
+// be within the range [first-1, last].
+func (f *historyFile) root(id uint64) common.Hash {
+	return f.roots[id-f.header.First+1]
+}
+
+// iterate traverses the histories within the range [start, end] and invokes
+// the callback with their raw freezer items. The trienode items are nil if
+// they are not included in the file.
+func (f *historyFile) iterate(start, end uint64, fn func(id uint64, states [][]byte, trienodes [][]byte) error) error {
+	read := func(offset int64, typ uint16, count int) ([][]byte, int64, error) {
+		var items [][]byte
+		for i := 0; i < count; i++ {
+			var entry e2store.Entry
+			n, err := f.reader.ReadAt(&entry, offset)
+			if err != nil {
+				return nil, 0, err
+			}
+			if entry.Type != typ+uint16(i) {
+				return nil, 0, fmt.Errorf("unexpected record type, want: %#x, got: %#x", typ+uint16(i), entry.Type)
+			}
+			items = append(items, entry.Value)
+			offset += int64(n)
+		}
+		return items, offset, nil
+	}
+	offset := f.body
+	for id := f.header.First; id <= end; id++ {
+		// Skip the histories before the start without decoding them
+		if id < start {
+			count := uint64(stateHistoryItems)
+			if f.header.Trienode {
+				count += trienodeHistoryItems
+			}
+			next, err := f.reader.SkipN(offset, count)
+			if err != nil {
+				return err
+			}
+			offset = next
+			continue
+		}
+		states, next, err := read(offset, historyTypeState, stateHistoryItems)
+		if err != nil {
+			return err
+		}
+		offset = next
+
+		var trienodes [][]byte
+		if f.header.Trienode {
+			trienodes, next, err = read(offset, historyTypeTrienode, trienodeHistoryItems)
+			if err != nil {
+				return err
+			}
+			offset = next
+		}
+		if err := fn(id, states, trienodes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportHistory writes the histories within the range [first, last] into the
+// writer as a self-describing and checksummed file. Zero first means the oldest
+// history and zero last means the latest history in the local store. The
+// trienode histories are included as well if they are maintained.
+func (db *Database) ExportHistory(w io.Writer, first, last uint64) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.stateFreezer == nil {
+		return errors.New("state histories are not maintained")
+	}
+	tail, err := db.stateFreezer.Tail()
+	if err != nil {
+		return err
+	}
+	head, err := db.stateFreezer.Ancients()
+	if err != nil {
+		return err
+	}
+	if first == 0 {
+		first = tail + 1
+	}
+	if last == 0 {
+		last = head
+	}
+	if first <= tail || last > head || first > last {
+		return fmt.Errorf("history range [%d, %d] is not available, local range: [%d, %d]", first, last, tail+1, head)
+	}
+	var trienodes ethdb.AncientReaderOp
+	if db.trienodeFreezer != nil {
+		ttail, err := db.trienodeFreezer.Tail()
+		if err != nil {
+			return err
+		}
+		thead, err := db.trienodeFreezer.Ancients()
+		if err != nil {
+			return err
+		}
+		if first > ttail && last <= thead {
+			trienodes = db.trienodeFreezer
+		} else {
+			log.Warn("Trienode histories are not fully available, skip exporting", "first", first, "last", last, "tail", ttail+1, "head", thead)
+		}
+	}
+	return exportHistories(w, db.stateFreezer, trienodes, first, last)
+}
+
+// ImportHistory imports the histories contained in the given history export
+// file, extending the local histories backwards. The imported histories must
+// be contiguous with the local ones, namely the last imported history must
+// transform the state into the one the oldest local history is based on, or
+// into the persistent state if no local history is retained. The histories
+// overlapping with the local ones are ignored.
+//
+// The history index is not contained in the file. It's purged and rebuilt from
+// scratch in the background, as the imported histories are placed in front of
+// the indexed ones. The import is refused if the configured history limit would
+// prune the imported histories.
+//
+// Note, the freezer can only be extended at the head, so the local histories
+// are moved aside temporarily and appended back after the imported ones. The
+// local histories are restored if the import fails; if even that fails, they
+// are left in the temporary directory reported in the error. The procedure
+// must not be interrupted.
+func (db *Database) ImportHistory(r io.ReaderAt) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.readOnly {
+		return errDatabaseReadOnly
+	}
+	if db.waitSync {
+		return errDatabaseWaitSync
+	}
+	if db.stateFreezer == nil {
+		return errors.New("state histories are not maintained")
+	}
+	file, err := openHistoryFile(r)
+	if err != nil {
+		return err
+	}
+	if db.trienodeFreezer != nil && !file.header.Trienode {
+		return errors.New("trienode histories are maintained but not included in the file")
+	}
+	// Ensure the imported histories are contiguous with the local ones
+	stateTail, stateImport, err := db.checkImport(db.stateFreezer, typeStateHistory, file)
+	if err != nil {
+		return err
+	}
+	var trienodeTail uint64
+	var trienodeImport bool
+	if db.trienodeFreezer != nil {
+		trienodeTail, trienodeImport, err = db.checkImport(db.trienodeFreezer, typeTrienodeHistory, file)
+		if err != nil {
+			return err
+		}
+	}
+	if !stateImport && !trienodeImport {
+		return fmt.Errorf("no history to import, range: [%d, %d]", file.header.First, file.header.Last)
+	}
+	// Ensure the imported histories are not pruned by the history limit
+	if limit := db.config.StateHistory; limit != 0 {
+		if retained := db.tree.bottom().stateID() - (file.header.First - 1); retained > limit {
+			return fmt.Errorf("imported histories exceed the history limit, retained: %d, limit: %d (use --history.state=0 or at least %d)", retained, limit, retained)
+		}
+	}
+	log.Info("Importing histories, do not interrupt", "first", file.header.First, "last", file.header.Last)
+
+	// Terminate the history indexers, the imported histories will be
+	// indexed from scratch along with the local ones. The indexers are
+	// restarted regardless of the outcome, resuming from the retained
+	// index if nothing was imported.
+	if db.stateIndexer != nil {
+		db.stateIndexer.close()
+	}
+	if db.trienodeIndexer != nil {
+		db.trienodeIndexer.close()
+	}
+	defer func() {
+		if db.stateIndexer != nil {
+			db.stateIndexer = newHistoryIndexer(db.diskdb, db.stateFreezer, db.tree.bottom().stateID(), typeStateHistory)
+		}
+		if db.trienodeIndexer != nil {
+			db.trienodeIndexer = newHistoryIndexer(db.diskdb, db.trienodeFreezer, db.tree.bottom().stateID(), typeTrienodeHistory)
+		}
+	}()
+	var (
+		start = time.Now()
+		batch = db.diskdb.NewBatch()
+		last  uint64
+	)
+	if stateImport {
+		rawdb.DeleteStateHistoryIndexMetadata(batch)
+		rawdb.DeleteStateHistoryIndexes(batch)
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		if err := db.prependHistories(db.stateFreezer, typeStateHistory, file, stateTail); err != nil {
+			return err
+		}
+		last = stateTail
+	}
+	if trienodeImport {
+		batch.Reset()
+		rawdb.DeleteTrienodeHistoryIndexMetadata(batch)
+		rawdb.DeleteTrienodeHistoryIndexes(batch)
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		if err := db.prependHistories(db.trienodeFreezer, typeTrienodeHistory, file, trienodeTail); err != nil {
+			return err
+		}
+		last = max(last, trienodeTail)
+	}
+	// Write the root->id mappings of the imported states
+	batch.Reset()
+	for id := file.header.First - 1; id <= last; id++ {
+		rawdb.WriteStateID(batch, file.root(id), id)
+		if batch.ValueSize() > ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	log.Info("Imported histories", "first", file.header.First, "last", last, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// checkImport ensures the histories in the file can be placed in front of the
+// local histories in the given freezer. It returns the id of the last history
+// to import and the flag whether there is anything to import.
+func (db *Database) checkImport(store ethdb.AncientStore, typ historyType, file *historyFile) (uint64, bool, error) {
+	tail, err := store.Tail()
+	if err != nil {
+		return 0, false, err
+	}
+	head, err := store.Ancients()
+	if err != nil {
+		return 0, false, err
+	}
+	dl := db.tree.bottom()
+	if head != dl.stateID() {
+		return 0, false, fmt.Errorf("%s history is not aligned with the disk layer, head: %d, disk: %d", typ, head, dl.stateID())
+	}
+	// Local histories already cover the whole range of the file
+	if tail < file.header.First {
+		return 0, false, nil
+	}
+	if tail > file.header.Last {
+		return 0, false, fmt.Errorf("%s history gap, local tail: %d, file range: [%d, %d]", typ, tail+1, file.header.First, file.header.Last)
+	}
+	want := dl.rootHash()
+	if tail < head {
+		if typ == typeStateHistory {
+			m, err := readStateHistoryMeta(store, tail+1)
+			if err != nil {
+				return 0, false, err
+			}
+			want = m.parent
+		} else {
+			m, err := readTrienodeMetadata(store, tail+1)
+			if err != nil {
+				return 0, false, err
+			}
+			want = m.parent
+		}
+	}
+	if got := file.root(tail); got != want {
+		return 0, false, fmt.Errorf("%s history is not contiguous at %d, want: %x, got: %x", typ, tail, want, got)
+	}
+	return tail, true, nil
+}
+
+// prependHistories rebuilds the freezer with the histories within the range
+// [first, tail] from the file placed in front of the local histories.
+func (db *Database) prependHistories(store ethdb.ResettableAncientStore, typ historyType, file *historyFile, tail uint64) (err error) {
+	head, err := store.Ancients()
+	if err != nil {
+		return err
+	}
+	// Decode the histories to import upfront, so that a malformed file is
+	// rejected before the local histories are touched.
+	err = file.iterate(file.header.First, tail, func(id uint64, states [][]byte, trienodes [][]byte) error {
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	open := rawdb.NewStateFreezer
+	if typ == typeTrienodeHistory {
+		open = rawdb.NewTrienodeFreezer
+	}
+	// Move the local histories aside, the temporary freezer is placed
+	// alongside the original one if it's file-based.
+	var (
+		temp    ethdb.ResettableAncientStore
+		tempDir string
+		keep    bool // Whether the temporary freezer holds the only copy of the local histories
+	)
+	if tail < head {
+		dir, err := store.AncientDatadir()
+		if err != nil {
+			return err
+		}
+		if dir != "" {
+			tempDir, err = os.MkdirTemp(filepath.Dir(dir), "import-")
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if !keep {
+					os.RemoveAll(tempDir)
+				}
+			}()
+		}
+		temp, err = open(tempDir, db.isVerkle, false)
+		if err != nil {
+			return err
+		}
+		defer temp.Close()
+
+		if err := temp.ResetTo(tail); err != nil {
+			return err
+		}
+		if err := copyHistories(store, temp, typ, tail+1, head); err != nil {
+			return err
+		}
+		if err := temp.SyncAncient(); err != nil {
+			return err
+		}
+	}
+	// Rebuild the freezer with the imported histories and the local ones. If
+	// anything goes wrong from now on, the local histories are restored from
+	// the temporary freezer, or kept there if even the restoration fails.
+	if err := store.ResetTo(file.header.First - 1); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil || temp == nil {
+			return
+		}
+		if rerr := restoreHistories(temp, store, typ, tail, head); rerr != nil {
+			keep = true
+			log.Error("Failed to restore local histories", "type", typ, "first", tail+1, "last", head, "dir", tempDir, "err", rerr)
+			err = fmt.Errorf("%w, local %s histories [%d, %d] are kept in %s", err, typ, tail+1, head, tempDir)
+		}
+	}()
+	err = file.iterate(file.header.First, tail, func(id uint64, states [][]byte, trienodes [][]byte) error {
+		if typ == typeStateHistory {
+			return writeHistoryItems(store, typ, id, states)
+		}
+		return writeHistoryItems(store, typ, id, trienodes)
+	})
+	if err != nil {
+		return err
+	}
+	if temp != nil {
+		if err := copyHistories(temp, store, typ, tail+1, head); err != nil {
+			return err
+		}
+	}
+	return store.SyncAncient()
+}
+
+// restoreHistories resets the freezer to the local histories within the range
+// [tail+1, head] moved aside into the temporary freezer.
+func restoreHistories(temp ethdb.AncientReaderOp, store ethdb.ResettableAncientStore, typ historyType, tail, head uint64) error {
+	if err := store.ResetTo(tail); err != nil {
+		return err
+	}
+	if err := copyHistories(temp, store, typ, tail+1, head); err != nil {
+		return err
+	}
+	return store.SyncAncient()
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pathdb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+)
+
+func TestHistoryExportImport(t *testing.T) {
+	maxDiffLayers = 4
+	defer func() {
+		maxDiffLayers = 128
+	}()
+
+	config := &testerConfig{
+		stateHistory: 0,
+		layers:       64,
+		enableIndex:  true,
+		trienodes:    true,
+	}
+	env := newTester(t, config)
+	defer env.release()
+	waitIndexing(env.db)
+
+	var full, partial bytes.Buffer
+	if err := env.db.ExportHistory(&full, 0, 0); err != nil {
+		t.Fatalf("Failed to export histories: %v", err)
+	}
+	if err := env.db.ExportHistory(&partial, 1, 10); err != nil {
+		t.Fatalf("Failed to export histories: %v", err)
+	}
+	// Prune the oldest histories, simulating a node with limited retention
+	head, _ := env.db.stateFreezer.Ancients()
+	prune := head / 2
+	if _, err := truncateFromTail(env.db.stateFreezer, typeStateHistory, prune); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := truncateFromTail(env.db.trienodeFreezer, typeTrienodeHistory, prune); err != nil {
+		t.Fatal(err)
+	}
+	// The histories are not contiguous with the local ones
+	if err := env.db.ImportHistory(bytes.NewReader(partial.Bytes())); err == nil {
+		t.Fatal("Expected error for non-contiguous histories")
+	}
+	// The corrupted file should be rejected
+	corrupted := bytes.Clone(full.Bytes())
+	corrupted[len(corrupted)/2] ^= 0xff
+	if err := env.db.ImportHistory(bytes.NewReader(corrupted)); err == nil {
+		t.Fatal("Expected error for corrupted file")
+	}
+	// The imported histories would be pruned by the history limit
+	env.db.config.StateHistory = head - 1
+	if err := env.db.ImportHistory(bytes.NewReader(full.Bytes())); err == nil {
+		t.Fatal("Expected error for exceeding the history limit")
+	}
+	env.db.config.StateHistory = 0
+
+	if err := env.db.ImportHistory(bytes.NewReader(full.Bytes())); err != nil {
+		t.Fatalf("Failed to import histories: %v", err)
+	}
+	for _, store := range []interface {
+		Tail() (uint64, error)
+		Ancients() (uint64, error)
+	}{env.db.stateFreezer, env.db.trienodeFreezer} {
+		if tail, _ := store.Tail(); tail != 0 {
+			t.Fatalf("Unexpected history tail, want: 0, got: %d", tail)
+		}
+		if n, _ := store.Ancients(); n != head {
+			t.Fatalf("Unexpected history head, want: %d, got: %d", head, n)
+		}
+	}
+	// Nothing left to import
+	if err := env.db.ImportHistory(bytes.NewReader(full.Bytes())); err == nil {
+		t.Fatal("Expected error for duplicated import")
+	}
+	waitIndexing(env.db)
+
+	var (
+		dl = env.db.tree.bottom()
+		hr = newHistoryReader(env.db.diskdb, env.db.stateFreezer)
+	)
+	for i, root := range env.roots {
+		if root == dl.rootHash() {
+			break
+		}
+		if err := checkHistoricalState(env, root, uint64(i+1), hr); err != nil {
+			t.Fatal(err)
+		}
+		if err := checkHistoricalTrie(env, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Pile up more histories on top, ensuring the imported histories are not affected
+	env.extend(4)
+	waitIndexing(env.db)
+
+	for i, root := range env.roots {
+		if root == dl.rootHash() {
+			break
+		}
+		if err := checkHistoricalState(env, root, uint64(i+1), hr); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// Tests that the local histories moved aside can be restored into the freezer
+// if the import fails halfway.
+func TestRestoreHistories(t *testing.T) {
+	env := newTester(t, &testerConfig{layers: 16})
+	defer env.release()
+
+	store := env.db.stateFreezer
+	head, _ := store.Ancients()
+	tail := head / 2
+
+	var want [][][]byte
+	for id := tail + 1; id <= head; id++ {
+		items, err := readHistoryItems(store, typeStateHistory, id)
+		if err != nil {
+			t.Fatalf("Failed to read history %d: %v", id, err)
+		}
+		want = append(want, items)
+	}
+	temp, err := rawdb.NewStateFreezer("", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer temp.Close()
+	if err := temp.ResetTo(tail); err != nil {
+		t.Fatal(err)
+	}
+	if err := copyHistories(store, temp, typeStateHistory, tail+1, head); err != nil {
+		t.Fatalf("Failed to move histories aside: %v", err)
+	}
+	// Wipe the local histories, as the import does before writing the
+	// imported ones, then restore them.
+	if err := store.ResetTo(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := restoreHistories(temp, store, typeStateHistory, tail, head); err != nil {
+		t.Fatalf("Failed to restore histories: %v", err)
+	}
+	if got, _ := store.Tail(); got != tail {
+		t.Fatalf("Unexpected history tail, want: %d, got: %d", tail, got)
+	}
+	for i, id := 0, tail+1; id <= head; i, id = i+1, id+1 {
+		items, err := readHistoryItems(store, typeStateHistory, id)
+		if err != nil {
+			t.Fatalf("Failed to read restored history %d: %v", id, err)
+		}
+		if !reflect.DeepEqual(items, want[i]) {
+			t.Fatalf("Restored history %d is different", id)
+		}
+	}
+}
@@ -220,6 +220,15 @@ func (r *historyReader) readStorage(address common.Address, storageKey common.Ha
 	return data, nil
 }
 
+// readTrienode retrieves the trie node data from the specified trienode history.
+func (r *historyReader) readTrienode(owner common.Hash, path string, historyID uint64) ([]byte, error) {
+	tr, err := newTrienodeHistoryReader(historyID, r.freezer)
+	if err != nil {
+		return nil, fmt.Errorf("trienode history is truncated, historyID: %d, %w", historyID, err)
+	}
+	return tr.read(owner, path)
+}
+
 // read retrieves the state element data associated with the stateID.
 // stateID: represents the ID of the state of the specified version;
 // lastID: represents the ID of the latest/newest state history;
@@ -272,8 +281,11 @@ func (r *historyReader) read(state stateIdentQuery, stateID uint64, lastID uint6
 	// that the associated state histories are no longer available due to a rollback.
 	// Such truncation should be captured by the state resolver below, rather than returning
 	// invalid data.
-	if state.typ == typeAccount {
+	switch state.typ {
+	case typeAccount:
 		return r.readAccount(state.address, historyID)
+	case typeTrienode:
+		return r.readTrienode(state.addressHash, state.path, historyID)
 	}
 	return r.readStorage(state.address, state.storageKey, state.storageHash, historyID)
 }
@@ -24,12 +24,27 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
 	"github.com/ethereum/go-ethereum/internal/testrand"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb/database"
 )
 
 func waitIndexing(db *Database) {
 	for {
 		metadata := loadIndexMetadata(db.diskdb, typeStateHistory)
+		if metadata != nil && metadata.Last >= db.tree.bottom().stateID() {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if db.trienodeIndexer == nil {
+		return
+	}
+	for {
+		metadata := loadIndexMetadata(db.diskdb, typeTrienodeHistory)
 		if metadata != nil && metadata.Last >= db.tree.bottom().stateID() {
 			return
 		}
@@ -215,3 +230,127 @@ func TestHistoricalStateReader(t *testing.T) {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 }
+
+// historicNodeDatabase implements database.NodeDatabase by resolving trie
+// nodes from the trienode histories.
+type historicNodeDatabase struct {
+	db *Database
+}
+
+func (h *historicNodeDatabase) NodeReader(root common.Hash) (database.NodeReader, error) {
+	return h.db.HistoricNodeReader(root)
+}
+
+func checkHistoricalTrie(env *tester, root common.Hash) error {
+	ndb := &historicNodeDatabase{db: env.db}
+	tr, err := trie.New(trie.StateTrieID(root), ndb)
+	if err != nil {
+		return err
+	}
+	for addrHash, account := range env.snapAccounts[root] {
+		blob, err := tr.Get(addrHash.Bytes())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(blob, account) {
+			return fmt.Errorf("wrong account data, expected %x, got %x", account, blob)
+		}
+		// Ensure the historical proof is valid against the state root
+		proof := memorydb.New()
+		if err := tr.Prove(addrHash.Bytes(), proof); err != nil {
+			return err
+		}
+		val, err := trie.VerifyProof(root, addrHash.Bytes(), proof)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(val, account) {
+			return fmt.Errorf("wrong proved account data, expected %x, got %x", account, val)
+		}
+	}
+	for addrHash, slots := range env.snapStorages[root] {
+		account := new(types.StateAccount)
+		if err := rlp.DecodeBytes(env.snapAccounts[root][addrHash], account); err != nil {
+			return err
+		}
+		st, err := trie.New(trie.StorageTrieID(root, addrHash, account.Root), ndb)
+		if err != nil {
+			return err
+		}
+		for slotHash, slot := range slots {
+			blob, err := st.Get(slotHash.Bytes())
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(blob, slot) {
+				return fmt.Errorf("wrong storage data, expected %x, got %x", slot, blob)
+			}
+		}
+	}
+	return nil
+}
+
+func TestHistoricalNodeReader(t *testing.T) {
+	maxDiffLayers = 4
+	defer func() {
+		maxDiffLayers = 128
+	}()
+
+	config := &testerConfig{
+		stateHistory: 0,
+		layers:       64,
+		enableIndex:  true,
+		trienodes:    true,
+	}
+	env := newTester(t, config)
+	defer env.release()
+	waitIndexing(env.db)
+
+	dl := env.db.tree.bottom()
+	for _, root := range env.roots {
+		if root == dl.rootHash() {
+			break
+		}
+		if err := checkHistoricalTrie(env, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Pile up more histories on top, ensuring the historic reader is not affected
+	env.extend(4)
+	waitIndexing(env.db)
+
+	for _, root := range env.roots {
+		if root == dl.rootHash() {
+			break
+		}
+		if err := checkHistoricalTrie(env, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// non-canonical state
+	fakeRoot := testrand.Hash()
+	rawdb.WriteStateID(env.db.diskdb, fakeRoot, 10)
+
+	if _, err := env.db.HistoricNodeReader(fakeRoot); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestHistoricalNodeReaderDisabled(t *testing.T) {
+	maxDiffLayers = 4
+	defer func() {
+		maxDiffLayers = 128
+	}()
+
+	config := &testerConfig{
+		layers:      16,
+		enableIndex: true,
+	}
+	env := newTester(t, config)
+	defer env.release()
+	waitIndexing(env.db)
+
+	if _, err := env.db.HistoricNodeReader(env.roots[0]); err == nil {
+		t.Fatal("expected error")
+	}
+}
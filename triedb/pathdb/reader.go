@@ -19,6 +19,7 @@ package pathdb
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -318,3 +319,76 @@ func (r *HistoricalStateReader) Storage(address common.Address, key common.Hash)
 	}
 	return r.reader.read(newStorageIdentQuery(address, addrHash, key, keyHash), r.id, dl.stateID(), latest)
 }
+
+// HistoricalNodeReader is a wrapper over history reader, providing access to
+// historical trie nodes. It implements the database.NodeReader interface and
+// can be used for constructing the historical tries, e.g. for proof generation.
+type HistoricalNodeReader struct {
+	db     *Database
+	reader *historyReader
+	id     uint64
+	lock   sync.Mutex // Lock for protecting the history reader
+}
+
+// HistoricNodeReader constructs a reader for accessing the trie nodes of the
+// requested historic state.
+func (db *Database) HistoricNodeReader(root common.Hash) (*HistoricalNodeReader, error) {
+	// Bail out if the trienode history hasn't been fully indexed
+	if db.trienodeIndexer == nil || db.trienodeFreezer == nil {
+		return nil, fmt.Errorf("historical trie nodes of %x are not available", root)
+	}
+	if !db.trienodeIndexer.inited() {
+		return nil, errors.New("trienode histories haven't been fully indexed yet")
+	}
+	id := rawdb.ReadStateID(db.diskdb, root)
+	if id == nil {
+		return nil, fmt.Errorf("state %#x is not available", root)
+	}
+	// Ensure the requested state is canonical, historical states on side chain
+	// are not accessible.
+	meta, err := readTrienodeMetadata(db.trienodeFreezer, *id+1)
+	if err != nil {
+		return nil, err // e.g., the referred trienode history has been pruned
+	}
+	if meta.parent != root {
+		return nil, fmt.Errorf("state %#x is not canonincal", root)
+	}
+	return &HistoricalNodeReader{
+		id:     *id,
+		db:     db,
+		reader: newHistoryReader(db.diskdb, db.trienodeFreezer),
+	}, nil
+}
+
+// Node implements database.NodeReader interface, retrieving the node with
+// specified node info. An error will be returned if the resolved node is
+// not matched with the requested one.
+func (r *HistoricalNodeReader) Node(owner common.Hash, path []byte, hash common.Hash) ([]byte, error) {
+	defer func(start time.Time) {
+		historicalNodeReadTimer.UpdateSince(start)
+	}(time.Now())
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// Same as the historical state reader, the obtained disk layer might
+	// become stale within a short time window, which is optimistically
+	// assumed to be very unlikely.
+	dl := r.db.tree.bottom()
+	latest, _, _, err := dl.node(owner, path, 0)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := r.reader.read(newTrienodeIdentQuery(owner, path), r.id, dl.stateID(), latest)
+	if err != nil {
+		return nil, err
+	}
+	got, err := r.db.hasher(blob)
+	if err != nil {
+		return nil, err
+	}
+	if got != hash {
+		return nil, fmt.Errorf("unexpected historical node: (%x %v), %x!=%x, state: %d", owner, path, hash, got, r.id)
+	}
+	return blob, nil
+}